@@ -0,0 +1,196 @@
+package algebra
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultBackend is the name of the pure Go backend that is registered and active by default.
+const DefaultBackend = "go"
+
+// Backend is the computational engine FlatMatrix operations are carried out by. It follows
+// the BLAS conventions, except that all matrices are stored row-major: the element at row i
+// and column j of a matrix with leading dimension ld is found at index i*ld+j.
+// Implementations must be safe for concurrent use and must not retain the slices they get.
+type Backend interface {
+	// Gemm computes c = alpha*a*b + beta*c, where a is m x k, b is k x n and c is m x n.
+	// When beta is zero c is overwritten without being read.
+	Gemm(m, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int)
+
+	// Gemv computes y = alpha*a*x + beta*y, where a is m x n, x has n elements and y has m.
+	// When beta is zero y is overwritten without being read.
+	Gemv(m, n int, alpha float64, a []float64, lda int, x []float64, beta float64, y []float64)
+
+	// Axpy computes y += alpha*x over the first len(x) elements of y.
+	Axpy(alpha float64, x, y []float64)
+
+	// Scal computes x = alpha*x in place. A zero alpha still multiplies every element, so
+	// NaNs and infinities in x become NaN.
+	Scal(alpha float64, x []float64)
+
+	// Dot returns the inner product of x and the first len(x) elements of y.
+	Dot(x, y []float64) float64
+
+	// Trsm solves a*x = alpha*b for the m x n matrix x, where a is m x m and triangular,
+	// overwriting b with x. upper selects the upper or lower triangle of a and unit states
+	// that the diagonal of a is all ones and must not be read.
+	Trsm(upper, unit bool, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int)
+}
+
+var (
+	backendsMu sync.RWMutex
+	backends   = map[string]Backend{DefaultBackend: goBackend{}}
+
+	// activeBackend holds the Backend operations currently dispatch through.
+	activeBackend atomic.Pointer[namedBackend]
+)
+
+type namedBackend struct {
+	name string
+	Backend
+}
+
+func init() {
+	activeBackend.Store(&namedBackend{name: DefaultBackend, Backend: goBackend{}})
+}
+
+// RegisterBackend makes a Backend available under name, to be activated with SetBackend.
+// Returns an error if b is nil or if name is already taken.
+func RegisterBackend(name string, b Backend) error {
+	if b == nil {
+		return ErrNilBackend
+	}
+
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+
+	if _, ok := backends[name]; ok {
+		return ErrBackendExists
+	}
+	backends[name] = b
+	return nil
+}
+
+// SetBackend makes the backend registered under name the one all subsequent operations
+// dispatch through. Returns ErrUnknownBackend if no backend was registered under name.
+func SetBackend(name string) error {
+	backendsMu.RLock()
+	b, ok := backends[name]
+	backendsMu.RUnlock()
+
+	if !ok {
+		return ErrUnknownBackend
+	}
+	activeBackend.Store(&namedBackend{name: name, Backend: b})
+	return nil
+}
+
+// CurrentBackend returns the name of the active backend and the backend itself.
+func CurrentBackend() (string, Backend) {
+	b := activeBackend.Load()
+	return b.name, b.Backend
+}
+
+// Backends returns the sorted names of all registered backends.
+func Backends() []string {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// backend returns the active backend.
+func backend() Backend {
+	return activeBackend.Load().Backend
+}
+
+// goBackend is the pure Go Backend, built on the blocked multiplication kernel and the
+// vector kernels.
+type goBackend struct{}
+
+func (goBackend) Gemm(m, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+	for i := 0; i < m; i++ {
+		scale(beta, c[i*ldc:i*ldc+n])
+	}
+	if alpha != 0 {
+		gemm(m, n, k, alpha, a, lda, b, ldb, c, ldc)
+	}
+}
+
+func (goBackend) Gemv(m, n int, alpha float64, a []float64, lda int, x []float64, beta float64, y []float64) {
+	x = x[:n]
+	for i := 0; i < m; i++ {
+		v := alpha * dot(x, a[i*lda:])
+		if beta != 0 {
+			v += beta * y[i]
+		}
+		y[i] = v
+	}
+}
+
+func (goBackend) Axpy(alpha float64, x, y []float64) {
+	axpy(alpha, x, y)
+}
+
+func (goBackend) Scal(alpha float64, x []float64) {
+	for i := range x {
+		x[i] *= alpha
+	}
+}
+
+func (goBackend) Dot(x, y []float64) float64 {
+	return dot(x, y)
+}
+
+func (goBackend) Trsm(upper, unit bool, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
+	row := func(i int) []float64 {
+		return b[i*ldb : i*ldb+n]
+	}
+
+	if alpha != 1 {
+		for i := 0; i < m; i++ {
+			scale(alpha, row(i))
+		}
+	}
+
+	solve := func(i, lo, hi int) {
+		bi := row(i)
+		for c := lo; c < hi; c++ {
+			if f := a[i*lda+c]; f != 0 {
+				axpy(-f, row(c), bi)
+			}
+		}
+		if !unit {
+			scale(1/a[i*lda+i], bi)
+		}
+	}
+
+	if upper {
+		for i := m - 1; i >= 0; i-- {
+			solve(i, i+1, m)
+		}
+		return
+	}
+	for i := 0; i < m; i++ {
+		solve(i, 0, i)
+	}
+}
+
+// scale multiplies x by alpha in place. A zero alpha clears x, discarding NaNs and infinities.
+func scale(alpha float64, x []float64) {
+	switch alpha {
+	case 0:
+		clear(x)
+	case 1:
+	default:
+		for i := range x {
+			x[i] *= alpha
+		}
+	}
+}
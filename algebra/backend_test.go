@@ -0,0 +1,251 @@
+package algebra
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// countingBackend forwards to the pure Go backend and counts the calls it receives.
+type countingBackend struct {
+	goBackend
+	calls map[string]int
+}
+
+func (b *countingBackend) Gemm(m, n, k int, alpha float64, a []float64, lda int, x []float64, ldb int, beta float64, c []float64, ldc int) {
+	b.calls["Gemm"]++
+	b.goBackend.Gemm(m, n, k, alpha, a, lda, x, ldb, beta, c, ldc)
+}
+
+func (b *countingBackend) Gemv(m, n int, alpha float64, a []float64, lda int, x []float64, beta float64, y []float64) {
+	b.calls["Gemv"]++
+	b.goBackend.Gemv(m, n, alpha, a, lda, x, beta, y)
+}
+
+func (b *countingBackend) Axpy(alpha float64, x, y []float64) {
+	b.calls["Axpy"]++
+	b.goBackend.Axpy(alpha, x, y)
+}
+
+func (b *countingBackend) Scal(alpha float64, x []float64) {
+	b.calls["Scal"]++
+	b.goBackend.Scal(alpha, x)
+}
+
+func (b *countingBackend) Dot(x, y []float64) float64 {
+	b.calls["Dot"]++
+	return b.goBackend.Dot(x, y)
+}
+
+var counting = &countingBackend{calls: map[string]int{}}
+
+func TestRegisterBackend(t *testing.T) {
+	if err := RegisterBackend("nil", nil); err != ErrNilBackend {
+		t.Errorf("RegisterBackend() error = %v, want %v", err, ErrNilBackend)
+	}
+	if err := RegisterBackend(DefaultBackend, goBackend{}); err != ErrBackendExists {
+		t.Errorf("RegisterBackend() error = %v, want %v", err, ErrBackendExists)
+	}
+	if err := RegisterBackend("counting", counting); err != nil && err != ErrBackendExists {
+		t.Fatalf("RegisterBackend() error = %v", err)
+	}
+
+	names := Backends()
+	if !reflect.DeepEqual(names, []string{"counting", DefaultBackend}) {
+		t.Errorf("Backends() = %v", names)
+	}
+}
+
+func TestSetBackend(t *testing.T) {
+	if err := RegisterBackend("counting", counting); err != nil && err != ErrBackendExists {
+		t.Fatalf("RegisterBackend() error = %v", err)
+	}
+
+	if err := SetBackend("missing"); err != ErrUnknownBackend {
+		t.Errorf("SetBackend() error = %v, want %v", err, ErrUnknownBackend)
+	}
+	if name, _ := CurrentBackend(); name != DefaultBackend {
+		t.Errorf("CurrentBackend() = %q, want %q", name, DefaultBackend)
+	}
+
+	if err := SetBackend("counting"); err != nil {
+		t.Fatalf("SetBackend() error = %v", err)
+	}
+	defer SetBackend(DefaultBackend)
+	clear(counting.calls)
+
+	if name, b := CurrentBackend(); name != "counting" || b != Backend(counting) {
+		t.Errorf("CurrentBackend() = %q, %v", name, b)
+	}
+
+	m := &FlatMatrix{data: []float64{1, 2, 3, 4}, rows: 2, cols: 2}
+	if got, _ := m.Mul(m); !reflect.DeepEqual(got, &FlatMatrix{data: []float64{7, 10, 15, 22}, rows: 2, cols: 2}) {
+		t.Errorf("FlatMatrix.Mul() = %v", got)
+	}
+	if got, _ := m.Add(m); !reflect.DeepEqual(got, &FlatMatrix{data: []float64{2, 4, 6, 8}, rows: 2, cols: 2}) {
+		t.Errorf("FlatMatrix.Add() = %v", got)
+	}
+	if got, _ := m.Sub(m); !reflect.DeepEqual(got, &FlatMatrix{data: []float64{0, 0, 0, 0}, rows: 2, cols: 2}) {
+		t.Errorf("FlatMatrix.Sub() = %v", got)
+	}
+	if got, _ := m.ScalarMul(2); !reflect.DeepEqual(got, &FlatMatrix{data: []float64{2, 4, 6, 8}, rows: 2, cols: 2}) {
+		t.Errorf("FlatMatrix.ScalarMul() = %v", got)
+	}
+	if got, _ := m.MulVec([]float64{1, 1}); !reflect.DeepEqual(got, []float64{3, 7}) {
+		t.Errorf("FlatMatrix.MulVec() = %v", got)
+	}
+
+	scaled := &FlatMatrix{data: []float64{1, 2, 3, 4}, rows: 2, cols: 2}
+	scaled.ScaleInPlace(2)
+	if !reflect.DeepEqual(scaled.data, []float64{2, 4, 6, 8}) {
+		t.Errorf("FlatMatrix.ScaleInPlace() = %v", scaled.data)
+	}
+	view, _ := scaled.View(1, 0, 1, 2)
+	view.(*MatrixView).ScaleInPlace(-1)
+	if !reflect.DeepEqual(scaled.data, []float64{2, 4, -6, -8}) {
+		t.Errorf("MatrixView.ScaleInPlace() = %v", scaled.data)
+	}
+	if err := view.(*MatrixView).AddInPlace(&FlatMatrix{data: []float64{6, 8}, rows: 1, cols: 2}); err != nil {
+		t.Fatalf("MatrixView.AddInPlace() error = %v", err)
+	}
+	if !reflect.DeepEqual(scaled.data, []float64{2, 4, 0, 0}) {
+		t.Errorf("MatrixView.AddInPlace() = %v", scaled.data)
+	}
+
+	want := map[string]int{"Gemm": 1, "Gemv": 1, "Axpy": 6, "Scal": 3}
+	if !reflect.DeepEqual(counting.calls, want) {
+		t.Errorf("backend calls = %v, want %v", counting.calls, want)
+	}
+}
+
+func TestGoBackend_Gemm(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tests := []struct {
+		alpha float64
+		beta  float64
+	}{
+		{alpha: 1, beta: 0},
+		{alpha: 2, beta: 1},
+		{alpha: -0.5, beta: 3},
+		{alpha: 0, beta: 2},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("Test Gemm with alpha %v and beta %v", tt.alpha, tt.beta), func(t *testing.T) {
+			a := randomMatrix(r, 7, 5)
+			b := randomMatrix(r, 5, 9)
+			c := randomMatrix(r, 7, 9)
+
+			ab, _ := mulNaive(a, b)
+			want := make([]float64, len(c.data))
+			for i := range want {
+				want[i] = tt.alpha*ab.(*FlatMatrix).data[i] + tt.beta*c.data[i]
+			}
+
+			goBackend{}.Gemm(7, 9, 5, tt.alpha, a.data, 5, b.data, 9, tt.beta, c.data, 9)
+			if !approxEqualFlat(c, &FlatMatrix{data: want, rows: 7, cols: 9}, 1e-12) {
+				t.Errorf("Gemm() = %v, want %v", c.data, want)
+			}
+		})
+	}
+}
+
+func TestGoBackend_Gemv(t *testing.T) {
+	a := []float64{
+		1, 2, 3, 0,
+		4, 5, 6, 0,
+	}
+	x := []float64{1, 0, -1}
+
+	y := []float64{10, 20}
+	goBackend{}.Gemv(2, 3, 2, a, 4, x, 1, y)
+	if want := []float64{6, 16}; !reflect.DeepEqual(y, want) {
+		t.Errorf("Gemv() = %v, want %v", y, want)
+	}
+
+	y = []float64{10, 20}
+	goBackend{}.Gemv(2, 3, 1, a, 4, x, 0, y)
+	if want := []float64{-2, -2}; !reflect.DeepEqual(y, want) {
+		t.Errorf("Gemv() = %v, want %v", y, want)
+	}
+}
+
+func TestGoBackend_Trsm(t *testing.T) {
+	tests := []struct {
+		name  string
+		upper bool
+		unit  bool
+		a     []float64
+		b     []float64
+		want  []float64
+	}{
+		{
+			name:  "Test lower triangular solve",
+			upper: false,
+			a:     []float64{2, 0, 0, 1, 4, 0, 3, 2, 1},
+			b:     []float64{4, 2, 10, 9, 15, 8},
+			want:  []float64{2, 1, 2, 2, 5, 1},
+		},
+		{
+			name:  "Test unit lower triangular solve ignores the diagonal",
+			upper: false,
+			unit:  true,
+			a:     []float64{7, 0, 0, 1, 7, 0, 3, 2, 7},
+			b:     []float64{2, 1, 4, 3, 14, 8},
+			want:  []float64{2, 1, 2, 2, 4, 1},
+		},
+		{
+			name:  "Test upper triangular solve",
+			upper: true,
+			a:     []float64{2, 1, 3, 0, 4, 2, 0, 0, 1},
+			b:     []float64{12, 6, 12, 6, 2, 1},
+			want:  []float64{2, 1, 2, 1, 2, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := append([]float64(nil), tt.b...)
+			goBackend{}.Trsm(tt.upper, tt.unit, 3, 2, 1, tt.a, 3, b, 2)
+			if !reflect.DeepEqual(b, tt.want) {
+				t.Errorf("Trsm() = %v, want %v", b, tt.want)
+			}
+
+			b = append([]float64(nil), tt.b...)
+			goBackend{}.Trsm(tt.upper, tt.unit, 3, 2, 2, tt.a, 3, b, 2)
+			for i := range b {
+				if b[i] != 2*tt.want[i] {
+					t.Errorf("Trsm() with alpha 2 = %v, want twice %v", b, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestGoBackend_Scal(t *testing.T) {
+	x := []float64{1, -2, 3}
+	goBackend{}.Scal(2, x)
+	if want := []float64{2, -4, 6}; !reflect.DeepEqual(x, want) {
+		t.Errorf("Scal() = %v, want %v", x, want)
+	}
+
+	x = []float64{1, math.Inf(1), math.NaN()}
+	goBackend{}.Scal(0, x)
+	if x[0] != 0 || !math.IsNaN(x[1]) || !math.IsNaN(x[2]) {
+		t.Errorf("Scal() with alpha 0 = %v, want [0 NaN NaN]", x)
+	}
+}
+
+func TestScaleInPlace_DoesNotAllocate(t *testing.T) {
+	m := &FlatMatrix{data: make([]float64, 64), rows: 8, cols: 8}
+	v, _ := m.View(1, 1, 4, 4)
+	view := v.(*MatrixView)
+
+	if n := testing.AllocsPerRun(10, func() { m.ScaleInPlace(2) }); n != 0 {
+		t.Errorf("FlatMatrix.ScaleInPlace() allocations = %v, want 0", n)
+	}
+	if n := testing.AllocsPerRun(10, func() { view.ScaleInPlace(2) }); n != 0 {
+		t.Errorf("MatrixView.ScaleInPlace() allocations = %v, want 0", n)
+	}
+}
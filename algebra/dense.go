@@ -0,0 +1,179 @@
+package algebra
+
+// Scalar is the set of element types a Dense matrix can hold.
+type Scalar interface {
+	float32 | float64 | complex64 | complex128
+}
+
+// Dense is a row-major matrix with elements of type T. It mirrors the arithmetic of FlatMatrix
+// for other element types: float32 halves the memory footprint of float64, and complex64 and
+// complex128 support complex arithmetic, including the conjugate transpose.
+type Dense[T Scalar] struct {
+	data []T
+	rows int
+	cols int
+}
+
+// NewDense returns a Dense matrix holding a copy of the rows of data, which must all have the
+// same length.
+func NewDense[T Scalar](data [][]T) (*Dense[T], error) {
+	rows := len(data)
+	if rows == 0 {
+		return &Dense[T]{data: []T{}}, nil
+	}
+
+	cols := len(data[0])
+	flat := make([]T, 0, rows*cols)
+	for _, row := range data {
+		if len(row) != cols {
+			return nil, ErrNotRectangular
+		}
+		flat = append(flat, row...)
+	}
+	return &Dense[T]{data: flat, rows: rows, cols: cols}, nil
+}
+
+// NewDenseFlat returns a rows x cols Dense matrix backed by data, stored row-major.
+func NewDenseFlat[T Scalar](data []T, rows, cols int) (*Dense[T], error) {
+	if err := validateConstructor(len(data), rows, cols); err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		data = make([]T, rows*cols)
+	}
+	return &Dense[T]{data: data, rows: rows, cols: cols}, nil
+}
+
+// NewDenseZero returns a rows x cols Dense matrix of zeros.
+func NewDenseZero[T Scalar](rows, cols int) (*Dense[T], error) {
+	return NewDenseFlat[T](nil, rows, cols)
+}
+
+func (d *Dense[T]) Rows() int {
+	return d.rows
+}
+
+func (d *Dense[T]) Cols() int {
+	return d.cols
+}
+
+func (d *Dense[T]) At(i, j int) (T, error) {
+	if i < 0 || i >= d.rows || j < 0 || j >= d.cols {
+		return 0, ErrorIndexOutOfBounds
+	}
+	return d.data[i*d.cols+j], nil
+}
+
+func (d *Dense[T]) MustAt(i, j int) (v T) {
+	var err error
+	if v, err = d.At(i, j); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func (d *Dense[T]) Set(i, j int, v T) error {
+	if i < 0 || i >= d.rows || j < 0 || j >= d.cols {
+		return ErrorIndexOutOfBounds
+	}
+	d.data[i*d.cols+j] = v
+	return nil
+}
+
+func (d *Dense[T]) Empty() bool {
+	return d.rows == 0
+}
+
+func (d *Dense[T]) CompareDimensions(other *Dense[T]) bool {
+	if other == nil {
+		return false
+	}
+
+	return d.rows == other.rows && d.cols == other.cols
+}
+
+func (d *Dense[T]) Add(other *Dense[T]) (*Dense[T], error) {
+	return d.zip(other, func(a, b T) T { return a + b })
+}
+
+func (d *Dense[T]) Sub(other *Dense[T]) (*Dense[T], error) {
+	return d.zip(other, func(a, b T) T { return a - b })
+}
+
+func (d *Dense[T]) ScalarMul(scalar T) (*Dense[T], error) {
+	result := &Dense[T]{data: make([]T, len(d.data)), rows: d.rows, cols: d.cols}
+	for i, v := range d.data {
+		result.data[i] = v * scalar
+	}
+	return result, nil
+}
+
+// Mul returns the matrix product d*other. The loops run in i-k-j order so that the innermost
+// loop walks contiguous rows of both other and the result. Like FlatMatrix.Mul, zeros are not
+// skipped, so 0*Inf and 0*NaN propagate NaN into the result.
+func (d *Dense[T]) Mul(other *Dense[T]) (*Dense[T], error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if d.cols != other.rows {
+		return nil, ErrMulDimensions
+	}
+
+	n := other.cols
+	result := &Dense[T]{data: make([]T, d.rows*n), rows: d.rows, cols: n}
+	for i := 0; i < d.rows; i++ {
+		ci := result.data[i*n : (i+1)*n]
+		for k, a := range d.data[i*d.cols : (i+1)*d.cols] {
+			for j, b := range other.data[k*n : (k+1)*n] {
+				ci[j] += a * b
+			}
+		}
+	}
+	return result, nil
+}
+
+func (d *Dense[T]) Transpose() *Dense[T] {
+	result := &Dense[T]{data: make([]T, len(d.data)), rows: d.cols, cols: d.rows}
+	for i := 0; i < d.rows; i++ {
+		for j := 0; j < d.cols; j++ {
+			result.data[j*d.rows+i] = d.data[i*d.cols+j]
+		}
+	}
+	return result
+}
+
+// ConjTranspose returns the conjugate (Hermitian) transpose of the matrix. For real element
+// types it is the same as Transpose.
+func (d *Dense[T]) ConjTranspose() *Dense[T] {
+	result := d.Transpose()
+	switch data := any(result.data).(type) {
+	case []complex64:
+		for i, v := range data {
+			data[i] = complex(real(v), -imag(v))
+		}
+	case []complex128:
+		for i, v := range data {
+			data[i] = complex(real(v), -imag(v))
+		}
+	}
+	return result
+}
+
+// zip returns the element-wise combination f(d, other) of two matrices of equal dimensions.
+func (d *Dense[T]) zip(other *Dense[T], f func(a, b T) T) (*Dense[T], error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if !d.CompareDimensions(other) {
+		return nil, ErrInvalidDimensions
+	}
+
+	result := &Dense[T]{data: make([]T, len(d.data)), rows: d.rows, cols: d.cols}
+	for i, v := range d.data {
+		result.data[i] = f(v, other.data[i])
+	}
+	return result, nil
+}
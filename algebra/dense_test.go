@@ -0,0 +1,164 @@
+package algebra
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func mustDense[T Scalar](t *testing.T, data [][]T) *Dense[T] {
+	t.Helper()
+	d, err := NewDense(data)
+	if err != nil {
+		t.Fatalf("NewDense() error = %v", err)
+	}
+	return d
+}
+
+// testDenseArithmetic checks Add, Sub, ScalarMul, Mul and Transpose of 2x2 matrices with
+// small integer elements, which every element type represents exactly.
+func testDenseArithmetic[T Scalar](t *testing.T) {
+	a := mustDense(t, [][]T{{1, 2}, {3, 4}})
+	b := mustDense(t, [][]T{{5, 6}, {7, 8}})
+
+	tests := []struct {
+		name string
+		got  func() (*Dense[T], error)
+		want [][]T
+	}{
+		{name: "Add", got: func() (*Dense[T], error) { return a.Add(b) }, want: [][]T{{6, 8}, {10, 12}}},
+		{name: "Sub", got: func() (*Dense[T], error) { return a.Sub(b) }, want: [][]T{{-4, -4}, {-4, -4}}},
+		{name: "ScalarMul", got: func() (*Dense[T], error) { return a.ScalarMul(2) }, want: [][]T{{2, 4}, {6, 8}}},
+		{name: "Mul", got: func() (*Dense[T], error) { return a.Mul(b) }, want: [][]T{{19, 22}, {43, 50}}},
+		{name: "Transpose", got: func() (*Dense[T], error) { return a.Transpose(), nil }, want: [][]T{{1, 3}, {2, 4}}},
+		{name: "ConjTranspose", got: func() (*Dense[T], error) { return a.ConjTranspose(), nil }, want: [][]T{{1, 3}, {2, 4}}},
+	}
+	for _, tt := range tests {
+		t.Run("Test "+tt.name, func(t *testing.T) {
+			got, err := tt.got()
+			if err != nil {
+				t.Fatalf("%s() error = %v", tt.name, err)
+			}
+			if want := mustDense(t, tt.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s() = %v, want %v", tt.name, got, want)
+			}
+		})
+	}
+
+	if _, err := a.Add(mustDense(t, [][]T{{1, 2, 3}})); err != ErrInvalidDimensions {
+		t.Errorf("Add() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+	if _, err := a.Sub(nil); err != ErrNilMatrix {
+		t.Errorf("Sub() error = %v, want %v", err, ErrNilMatrix)
+	}
+	if _, err := a.Mul(mustDense(t, [][]T{{1, 2, 3}})); err != ErrMulDimensions {
+		t.Errorf("Mul() error = %v, want %v", err, ErrMulDimensions)
+	}
+}
+
+func TestDense_Arithmetic(t *testing.T) {
+	t.Run("float32", testDenseArithmetic[float32])
+	t.Run("float64", testDenseArithmetic[float64])
+	t.Run("complex64", testDenseArithmetic[complex64])
+	t.Run("complex128", testDenseArithmetic[complex128])
+}
+
+func TestDense_MulMatchesFlatMatrix(t *testing.T) {
+	// A zero times Inf must propagate NaN the same way FlatMatrix.Mul does.
+	data := [][]float64{{0, 1}, {2, 3}}
+	other := [][]float64{{math.Inf(1), 1}, {1, 2}}
+
+	got, err := mustDense(t, data).Mul(mustDense(t, other))
+	if err != nil {
+		t.Fatalf("Dense.Mul() error = %v", err)
+	}
+	a, _ := NewMatrix(data)
+	b, _ := NewMatrix(other)
+	want, err := a.Mul(b)
+	if err != nil {
+		t.Fatalf("FlatMatrix.Mul() error = %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			g, w := got.MustAt(i, j), want.MustAt(i, j)
+			if g != w && !(math.IsNaN(g) && math.IsNaN(w)) {
+				t.Errorf("Dense.Mul()[%d][%d] = %v, FlatMatrix.Mul() = %v", i, j, g, w)
+			}
+		}
+	}
+	if !math.IsNaN(got.MustAt(0, 0)) {
+		t.Errorf("Dense.Mul()[0][0] = %v, want NaN from 0*Inf", got.MustAt(0, 0))
+	}
+}
+
+func TestDense_Complex(t *testing.T) {
+	a := mustDense(t, [][]complex128{
+		{1 + 2i, 3 - 1i},
+		{-2i, 4},
+	})
+
+	want := mustDense(t, [][]complex128{
+		{1 - 2i, 2i},
+		{3 + 1i, 4},
+	})
+	if got := a.ConjTranspose(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ConjTranspose() = %v, want %v", got, want)
+	}
+
+	// A*Aᴴ is Hermitian with the squared row norms on its diagonal.
+	got, err := a.Mul(a.ConjTranspose())
+	if err != nil {
+		t.Fatalf("Mul() error = %v", err)
+	}
+	want = mustDense(t, [][]complex128{
+		{15, 8 - 2i},
+		{8 + 2i, 20},
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Mul() = %v, want %v", got, want)
+	}
+
+	b := mustDense(t, [][]complex64{{1i}})
+	if got := b.ConjTranspose().MustAt(0, 0); got != -1i {
+		t.Errorf("ConjTranspose() of complex64 = %v, want -1i", got)
+	}
+	if got, _ := b.ScalarMul(1i); got.MustAt(0, 0) != -1 {
+		t.Errorf("ScalarMul() of complex64 = %v, want -1", got.MustAt(0, 0))
+	}
+}
+
+func TestDense_Constructors(t *testing.T) {
+	if _, err := NewDense([][]float32{{1, 2}, {3}}); err != ErrNotRectangular {
+		t.Errorf("NewDense() error = %v, want %v", err, ErrNotRectangular)
+	}
+	if d, err := NewDense[float32](nil); err != nil || !d.Empty() {
+		t.Errorf("NewDense(nil) = %v, %v", d, err)
+	}
+	if d, err := NewDenseFlat([]float32{}, 3, 0); err != nil || d.Empty() {
+		t.Errorf("NewDenseFlat(3x0).Empty() = true, want false like FlatMatrix")
+	}
+	if _, err := NewDenseFlat([]float32{1, 2, 3}, 2, 2); err != ErrInvalidDimensions {
+		t.Errorf("NewDenseFlat() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+	if _, err := NewDenseZero[complex128](-1, 2); err != ErrInvalidDimensions {
+		t.Errorf("NewDenseZero() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+
+	d, err := NewDenseZero[float32](2, 3)
+	if err != nil {
+		t.Fatalf("NewDenseZero() error = %v", err)
+	}
+	if err := d.Set(1, 2, 5); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if d.Rows() != 2 || d.Cols() != 3 || d.MustAt(1, 2) != 5 || d.MustAt(0, 0) != 0 {
+		t.Errorf("NewDenseZero() = %v", d)
+	}
+	if _, err := d.At(2, 0); err != ErrorIndexOutOfBounds {
+		t.Errorf("At() error = %v, want %v", err, ErrorIndexOutOfBounds)
+	}
+	if err := d.Set(0, 3, 1); err != ErrorIndexOutOfBounds {
+		t.Errorf("Set() error = %v, want %v", err, ErrorIndexOutOfBounds)
+	}
+}
@@ -0,0 +1,345 @@
+package eigen
+
+import (
+	"math"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+// symmetryTolerance is the relative difference allowed between a[i][j] and a[j][i]
+// for a matrix to still be considered symmetric.
+const symmetryTolerance = 1e-12
+
+// epsilon is the machine epsilon for float64, the gap between 1 and the next representable value.
+const epsilon = 0x1p-52
+
+// LUDecomposition holds the factors of a P*A = L*U factorization computed
+// with partial (row) pivoting.
+type LUDecomposition struct {
+	// L is the unit lower-triangular factor with rows x min(rows, cols) elements.
+	L algebra.Matrix
+
+	// U is the upper-triangular factor with min(rows, cols) x cols elements.
+	U algebra.Matrix
+
+	// P is the rows x rows permutation matrix such that P*A = L*U.
+	P algebra.Matrix
+
+	// Pivot records the row permutation: row i of P*A is row Pivot[i] of A.
+	Pivot []int
+
+	// Sign is the parity of the permutation, +1 for an even number of row swaps and -1 otherwise.
+	Sign float64
+
+	// lu holds the packed factors as returned by luFactorize.
+	lu []float64
+}
+
+// LU computes the LU factorization of m using Gaussian elimination with partial pivoting.
+// Rectangular matrices are supported. Singular matrices are factorized as well, in which
+// case U has at least one zero on its diagonal.
+func LU(m algebra.Matrix) (*LUDecomposition, error) {
+	if m == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	rows, cols := m.Rows(), m.Cols()
+	k := min(rows, cols)
+	a := dense(m)
+	pivot, sign := luFactorize(a, rows, cols)
+
+	l := make([]float64, rows*k)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < k && j <= i; j++ {
+			if i == j {
+				l[i*k+j] = 1
+			} else {
+				l[i*k+j] = a[i*cols+j]
+			}
+		}
+	}
+
+	u := make([]float64, k*cols)
+	for i := 0; i < k; i++ {
+		for j := i; j < cols; j++ {
+			u[i*cols+j] = a[i*cols+j]
+		}
+	}
+
+	p := make([]float64, rows*rows)
+	for i, r := range pivot {
+		p[i*rows+r] = 1
+	}
+
+	return &LUDecomposition{
+		L:     newMatrix(l, rows, k),
+		U:     newMatrix(u, k, cols),
+		P:     newMatrix(p, rows, rows),
+		Pivot: pivot,
+		Sign:  sign,
+		lu:    a,
+	}, nil
+}
+
+// luFactorize overwrites the row-major rows x cols slice a with its packed LU factors:
+// the strict lower triangle holds L (without its unit diagonal) and the upper triangle holds U.
+// It returns the row permutation and its parity.
+func luFactorize(a []float64, rows, cols int) ([]int, float64) {
+	pivot := make([]int, rows)
+	for i := range pivot {
+		pivot[i] = i
+	}
+	sign := 1.0
+
+	for k := 0; k < min(rows, cols); k++ {
+		p := k
+		largest := math.Abs(a[k*cols+k])
+		for i := k + 1; i < rows; i++ {
+			if v := math.Abs(a[i*cols+k]); v > largest {
+				p, largest = i, v
+			}
+		}
+
+		if p != k {
+			for j := 0; j < cols; j++ {
+				a[p*cols+j], a[k*cols+j] = a[k*cols+j], a[p*cols+j]
+			}
+			pivot[p], pivot[k] = pivot[k], pivot[p]
+			sign = -sign
+		}
+
+		if a[k*cols+k] == 0 {
+			continue
+		}
+
+		for i := k + 1; i < rows; i++ {
+			a[i*cols+k] /= a[k*cols+k]
+			f := a[i*cols+k]
+			if f == 0 {
+				continue
+			}
+			for j := k + 1; j < cols; j++ {
+				a[i*cols+j] -= f * a[k*cols+j]
+			}
+		}
+	}
+
+	return pivot, sign
+}
+
+// QRDecomposition holds the factors of an A = Q*R factorization.
+type QRDecomposition struct {
+	// Q has orthonormal columns. It is rows x rows for a full factorization
+	// and rows x min(rows, cols) for a thin one.
+	Q algebra.Matrix
+
+	// R is upper-triangular. It is rows x cols for a full factorization
+	// and min(rows, cols) x cols for a thin one.
+	R algebra.Matrix
+}
+
+// QR computes the full QR factorization of m using Householder reflections.
+func QR(m algebra.Matrix) (*QRDecomposition, error) {
+	return qr(m, false)
+}
+
+// ThinQR computes the thin (economy) QR factorization of m using Householder reflections.
+// For tall matrices only the first cols columns of Q and the first cols rows of R are kept.
+func ThinQR(m algebra.Matrix) (*QRDecomposition, error) {
+	return qr(m, true)
+}
+
+func qr(m algebra.Matrix, thin bool) (*QRDecomposition, error) {
+	if m == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	rows, cols := m.Rows(), m.Cols()
+	a := dense(m)
+	reflectors := householder(a, rows, cols)
+
+	qCols, rRows := rows, rows
+	if thin {
+		qCols, rRows = min(rows, cols), min(rows, cols)
+	}
+
+	q := accumulateReflectors(reflectors, rows, qCols)
+
+	r := make([]float64, rRows*cols)
+	for i := 0; i < rRows; i++ {
+		for j := i; j < cols; j++ {
+			r[i*cols+j] = a[i*cols+j]
+		}
+	}
+
+	return &QRDecomposition{
+		Q: newMatrix(q, rows, qCols),
+		R: newMatrix(r, rRows, cols),
+	}, nil
+}
+
+// householder reduces the row-major rows x cols slice a to upper-triangular form in place.
+// It returns the Householder vectors used, where reflector k acts on rows k through rows-1.
+// A nil reflector stands for the identity.
+func householder(a []float64, rows, cols int) [][]float64 {
+	steps := max(min(rows-1, cols), 0)
+	reflectors := make([][]float64, steps)
+
+	for k := 0; k < steps; k++ {
+		var norm float64
+		for i := k; i < rows; i++ {
+			norm = math.Hypot(norm, a[i*cols+k])
+		}
+		if norm == 0 {
+			continue
+		}
+
+		alpha := -math.Copysign(norm, a[k*cols+k])
+		v := make([]float64, rows-k)
+		for i := k; i < rows; i++ {
+			v[i-k] = a[i*cols+k]
+		}
+		v[0] -= alpha
+
+		applyReflector(a, rows, cols, k, k, v)
+		reflectors[k] = v
+	}
+
+	return reflectors
+}
+
+// accumulateReflectors forms the first qCols columns of the orthogonal rows x rows matrix
+// Q = H_0*H_1*...*H_k described by reflectors, as a row-major slice.
+func accumulateReflectors(reflectors [][]float64, rows, qCols int) []float64 {
+	q := make([]float64, rows*qCols)
+	for i := 0; i < min(rows, qCols); i++ {
+		q[i*qCols+i] = 1
+	}
+	for k := len(reflectors) - 1; k >= 0; k-- {
+		applyReflector(q, rows, qCols, k, 0, reflectors[k])
+	}
+	return q
+}
+
+// applyReflector applies the Householder reflection H = I - 2*v*vᵀ/(vᵀ*v) to the rows k through rows-1
+// of the row-major rows x cols slice a, touching only the columns from col onwards.
+func applyReflector(a []float64, rows, cols, k, col int, v []float64) {
+	if v == nil {
+		return
+	}
+
+	var vv float64
+	for _, x := range v {
+		vv += x * x
+	}
+	if vv == 0 {
+		return
+	}
+
+	for j := col; j < cols; j++ {
+		var dot float64
+		for i := k; i < rows; i++ {
+			dot += v[i-k] * a[i*cols+j]
+		}
+		f := 2 * dot / vv
+		for i := k; i < rows; i++ {
+			a[i*cols+j] -= f * v[i-k]
+		}
+	}
+}
+
+// Cholesky computes the lower-triangular factor L of a symmetric positive-definite matrix
+// such that A = L*Lᵀ. It returns algebra.ErrNotSquare for rectangular input and
+// algebra.ErrNotPositiveDefinite when m is not symmetric or not positive-definite.
+func Cholesky(m algebra.Matrix) (algebra.Matrix, error) {
+	if m == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	n := m.Rows()
+	if n != m.Cols() {
+		return nil, algebra.ErrNotSquare
+	}
+
+	a := dense(m)
+	if !isSymmetric(a, n) {
+		return nil, algebra.ErrNotPositiveDefinite
+	}
+
+	l := make([]float64, n*n)
+	for j := 0; j < n; j++ {
+		d := a[j*n+j]
+		for k := 0; k < j; k++ {
+			d -= l[j*n+k] * l[j*n+k]
+		}
+		if d <= 0 || math.IsNaN(d) {
+			return nil, algebra.ErrNotPositiveDefinite
+		}
+		d = math.Sqrt(d)
+		l[j*n+j] = d
+
+		for i := j + 1; i < n; i++ {
+			s := a[i*n+j]
+			for k := 0; k < j; k++ {
+				s -= l[i*n+k] * l[j*n+k]
+			}
+			l[i*n+j] = s / d
+		}
+	}
+
+	return newMatrix(l, n, n), nil
+}
+
+// isSymmetric reports whether the row-major n x n slice a is symmetric up to rounding errors.
+func isSymmetric(a []float64, n int) bool {
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			x, y := a[i*n+j], a[j*n+i]
+			if math.Abs(x-y) > symmetryTolerance*max(math.Abs(x), math.Abs(y), 1) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// dense copies the elements of m into a freshly allocated row-major slice.
+func dense(m algebra.Matrix) []float64 {
+	rows, cols := m.Rows(), m.Cols()
+	data := make([]float64, rows*cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			data[i*cols+j] = m.MustAt(i, j)
+		}
+	}
+	return data
+}
+
+// transpose returns the cols x rows transpose of the row-major rows x cols slice a.
+func transpose(a []float64, rows, cols int) []float64 {
+	t := make([]float64, rows*cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			t[j*rows+i] = a[i*cols+j]
+		}
+	}
+	return t
+}
+
+// dot returns the inner product of two equally sized vectors.
+func dot(x, y []float64) float64 {
+	var sum float64
+	for i := range x {
+		sum += x[i] * y[i]
+	}
+	return sum
+}
+
+// newMatrix wraps a row-major slice into an algebra.Matrix, panicking on inconsistent dimensions.
+func newMatrix(data []float64, rows, cols int) algebra.Matrix {
+	m, err := algebra.NewMatrixFlat(data, rows, cols)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
@@ -0,0 +1,383 @@
+package eigen
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+const tolerance = 1e-9
+
+func mustMatrix(t *testing.T, data [][]float64) algebra.Matrix {
+	t.Helper()
+	m, err := algebra.NewMatrix(data)
+	if err != nil {
+		t.Fatalf("NewMatrix() error = %v", err)
+	}
+	return m
+}
+
+func mustMul(t *testing.T, a, b algebra.Matrix) algebra.Matrix {
+	t.Helper()
+	m, err := a.Mul(b)
+	if err != nil {
+		t.Fatalf("Matrix.Mul() error = %v", err)
+	}
+	return m
+}
+
+func approxEqual(a, b algebra.Matrix, tol float64) bool {
+	if !a.CompareDimensions(b) {
+		return false
+	}
+	for i := 0; i < a.Rows(); i++ {
+		for j := 0; j < a.Cols(); j++ {
+			if math.Abs(a.MustAt(i, j)-b.MustAt(i, j)) > tol {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestLU(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      [][]float64
+		wantL     [][]float64
+		wantU     [][]float64
+		wantPivot []int
+		wantSign  float64
+	}{
+		{
+			name: "Test LU of 3x3 matrix with row swaps",
+			data: [][]float64{
+				{1, 2, 3},
+				{4, 5, 6},
+				{7, 8, 10},
+			},
+			wantL: [][]float64{
+				{1, 0, 0},
+				{1.0 / 7, 1, 0},
+				{4.0 / 7, 0.5, 1},
+			},
+			wantU: [][]float64{
+				{7, 8, 10},
+				{0, 6.0 / 7, 11.0 / 7},
+				{0, 0, -0.5},
+			},
+			wantPivot: []int{2, 0, 1},
+			wantSign:  1,
+		},
+		{
+			name: "Test LU of 2x2 matrix without row swaps",
+			data: [][]float64{
+				{4, 3},
+				{2, 1},
+			},
+			wantL: [][]float64{
+				{1, 0},
+				{0.5, 1},
+			},
+			wantU: [][]float64{
+				{4, 3},
+				{0, -0.5},
+			},
+			wantPivot: []int{0, 1},
+			wantSign:  1,
+		},
+		{
+			name: "Test LU of 2x2 matrix with a single row swap",
+			data: [][]float64{
+				{0, 1},
+				{2, 3},
+			},
+			wantL: [][]float64{
+				{1, 0},
+				{0, 1},
+			},
+			wantU: [][]float64{
+				{2, 3},
+				{0, 1},
+			},
+			wantPivot: []int{1, 0},
+			wantSign:  -1,
+		},
+		{
+			name: "Test LU of singular matrix",
+			data: [][]float64{
+				{1, 2},
+				{2, 4},
+			},
+			wantL: [][]float64{
+				{1, 0},
+				{0.5, 1},
+			},
+			wantU: [][]float64{
+				{2, 4},
+				{0, 0},
+			},
+			wantPivot: []int{1, 0},
+			wantSign:  -1,
+		},
+		{
+			name: "Test LU of 3x2 rectangular matrix",
+			data: [][]float64{
+				{1, 2},
+				{3, 4},
+				{5, 6},
+			},
+			wantL: [][]float64{
+				{1, 0},
+				{0.2, 1},
+				{0.6, 0.5},
+			},
+			wantU: [][]float64{
+				{5, 6},
+				{0, 0.8},
+			},
+			wantPivot: []int{2, 0, 1},
+			wantSign:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustMatrix(t, tt.data)
+			got, err := LU(a)
+			if err != nil {
+				t.Fatalf("LU() error = %v", err)
+			}
+			if !approxEqual(got.L, mustMatrix(t, tt.wantL), tolerance) {
+				t.Errorf("LU() L = %v, want %v", got.L, tt.wantL)
+			}
+			if !approxEqual(got.U, mustMatrix(t, tt.wantU), tolerance) {
+				t.Errorf("LU() U = %v, want %v", got.U, tt.wantU)
+			}
+			if !reflect.DeepEqual(got.Pivot, tt.wantPivot) {
+				t.Errorf("LU() Pivot = %v, want %v", got.Pivot, tt.wantPivot)
+			}
+			if got.Sign != tt.wantSign {
+				t.Errorf("LU() Sign = %v, want %v", got.Sign, tt.wantSign)
+			}
+			if !approxEqual(mustMul(t, got.P, a), mustMul(t, got.L, got.U), tolerance) {
+				t.Errorf("LU() P*A != L*U")
+			}
+		})
+	}
+}
+
+func TestLU_Nil(t *testing.T) {
+	if _, err := LU(nil); err != algebra.ErrNilMatrix {
+		t.Errorf("LU() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+}
+
+func identity(n int) algebra.Matrix {
+	data := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		data[i*n+i] = 1
+	}
+	m, _ := algebra.NewMatrixFlat(data, n, n)
+	return m
+}
+
+func isUpperTriangular(m algebra.Matrix, tol float64) bool {
+	for i := 0; i < m.Rows(); i++ {
+		for j := 0; j < i && j < m.Cols(); j++ {
+			if math.Abs(m.MustAt(i, j)) > tol {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestQR(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      [][]float64
+		thin      bool
+		wantQRows int
+		wantQCols int
+		wantRRows int
+		wantRCols int
+	}{
+		{
+			name: "Test full QR of 3x3 matrix",
+			data: [][]float64{
+				{12, -51, 4},
+				{6, 167, -68},
+				{-4, 24, -41},
+			},
+			wantQRows: 3, wantQCols: 3, wantRRows: 3, wantRCols: 3,
+		},
+		{
+			name: "Test full QR of 4x2 tall matrix",
+			data: [][]float64{
+				{1, 2},
+				{3, 4},
+				{5, 6},
+				{7, 8},
+			},
+			wantQRows: 4, wantQCols: 4, wantRRows: 4, wantRCols: 2,
+		},
+		{
+			name: "Test thin QR of 4x2 tall matrix",
+			data: [][]float64{
+				{1, 2},
+				{3, 4},
+				{5, 6},
+				{7, 8},
+			},
+			thin:      true,
+			wantQRows: 4, wantQCols: 2, wantRRows: 2, wantRCols: 2,
+		},
+		{
+			name: "Test full QR of 2x3 wide matrix",
+			data: [][]float64{
+				{1, 2, 3},
+				{4, 5, 6},
+			},
+			wantQRows: 2, wantQCols: 2, wantRRows: 2, wantRCols: 3,
+		},
+		{
+			name: "Test thin QR of rank deficient matrix",
+			data: [][]float64{
+				{1, 2},
+				{2, 4},
+				{3, 6},
+			},
+			thin:      true,
+			wantQRows: 3, wantQCols: 2, wantRRows: 2, wantRCols: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustMatrix(t, tt.data)
+			decompose := QR
+			if tt.thin {
+				decompose = ThinQR
+			}
+			got, err := decompose(a)
+			if err != nil {
+				t.Fatalf("QR() error = %v", err)
+			}
+			if got.Q.Rows() != tt.wantQRows || got.Q.Cols() != tt.wantQCols {
+				t.Errorf("QR() Q is %dx%d, want %dx%d", got.Q.Rows(), got.Q.Cols(), tt.wantQRows, tt.wantQCols)
+			}
+			if got.R.Rows() != tt.wantRRows || got.R.Cols() != tt.wantRCols {
+				t.Errorf("QR() R is %dx%d, want %dx%d", got.R.Rows(), got.R.Cols(), tt.wantRRows, tt.wantRCols)
+			}
+			if !isUpperTriangular(got.R, tolerance) {
+				t.Errorf("QR() R = %v is not upper triangular", got.R)
+			}
+			if !approxEqual(mustMul(t, got.Q.Transpose(), got.Q), identity(tt.wantQCols), tolerance) {
+				t.Errorf("QR() Q = %v does not have orthonormal columns", got.Q)
+			}
+			if !approxEqual(mustMul(t, got.Q, got.R), a, tolerance) {
+				t.Errorf("QR() Q*R != A")
+			}
+		})
+	}
+}
+
+func TestQR_Nil(t *testing.T) {
+	if _, err := QR(nil); err != algebra.ErrNilMatrix {
+		t.Errorf("QR() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+	if _, err := ThinQR(nil); err != algebra.ErrNilMatrix {
+		t.Errorf("ThinQR() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+}
+
+func TestCholesky(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    [][]float64
+		want    [][]float64
+		wantErr error
+	}{
+		{
+			name: "Test Cholesky of 3x3 SPD matrix",
+			data: [][]float64{
+				{4, 12, -16},
+				{12, 37, -43},
+				{-16, -43, 98},
+			},
+			want: [][]float64{
+				{2, 0, 0},
+				{6, 1, 0},
+				{-8, 5, 3},
+			},
+		},
+		{
+			name: "Test Cholesky of identity",
+			data: [][]float64{
+				{1, 0},
+				{0, 1},
+			},
+			want: [][]float64{
+				{1, 0},
+				{0, 1},
+			},
+		},
+		{
+			name: "Test Cholesky of indefinite matrix should return error",
+			data: [][]float64{
+				{1, 2},
+				{2, 1},
+			},
+			wantErr: algebra.ErrNotPositiveDefinite,
+		},
+		{
+			name: "Test Cholesky of positive semi-definite matrix should return error",
+			data: [][]float64{
+				{1, 1},
+				{1, 1},
+			},
+			wantErr: algebra.ErrNotPositiveDefinite,
+		},
+		{
+			name: "Test Cholesky of non-symmetric matrix should return error",
+			data: [][]float64{
+				{4, 1},
+				{2, 3},
+			},
+			wantErr: algebra.ErrNotPositiveDefinite,
+		},
+		{
+			name: "Test Cholesky of rectangular matrix should return error",
+			data: [][]float64{
+				{1, 2, 3},
+				{4, 5, 6},
+			},
+			wantErr: algebra.ErrNotSquare,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustMatrix(t, tt.data)
+			got, err := Cholesky(a)
+			if err != tt.wantErr {
+				t.Fatalf("Cholesky() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !approxEqual(got, mustMatrix(t, tt.want), tolerance) {
+				t.Errorf("Cholesky() = %v, want %v", got, tt.want)
+			}
+			if !approxEqual(mustMul(t, got, got.Transpose()), a, tolerance) {
+				t.Errorf("Cholesky() L*Lᵀ != A")
+			}
+		})
+	}
+}
+
+func TestCholesky_Nil(t *testing.T) {
+	if _, err := Cholesky(nil); err != algebra.ErrNilMatrix {
+		t.Errorf("Cholesky() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+}
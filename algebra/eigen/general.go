@@ -0,0 +1,604 @@
+package eigen
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+// maxFrancisIterations bounds the number of Francis double-shift QR iterations spent on
+// deflating a single eigenvalue or pair of eigenvalues.
+const maxFrancisIterations = 100
+
+// GeneralDecomposition holds the eigenvalues and right eigenvectors of a general real
+// square matrix A, such that A*Vectors[j] = Values[j]*Vectors[j].
+type GeneralDecomposition struct {
+	// Values are the eigenvalues. Complex eigenvalues come in adjacent conjugate pairs,
+	// the one with positive imaginary part first.
+	Values []complex128
+
+	// Vectors holds the right eigenvectors normalized to unit length, where Vectors[j]
+	// belongs to Values[j]. It is nil when only the eigenvalues were requested.
+	Vectors [][]complex128
+}
+
+// General computes the eigenvalues and right eigenvectors of a real square matrix by
+// reducing it to upper Hessenberg form and then applying the Francis double-shift QR
+// algorithm. It returns algebra.ErrNotSquare for rectangular input.
+func General(m algebra.Matrix) (*GeneralDecomposition, error) {
+	return general(m, true)
+}
+
+// GeneralValues computes only the eigenvalues of a real square matrix, which is cheaper
+// than General as no transformations are accumulated.
+func GeneralValues(m algebra.Matrix) ([]complex128, error) {
+	d, err := general(m, false)
+	if err != nil {
+		return nil, err
+	}
+	return d.Values, nil
+}
+
+func general(m algebra.Matrix, wantVectors bool) (*GeneralDecomposition, error) {
+	if m == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	n := m.Rows()
+	if n != m.Cols() {
+		return nil, algebra.ErrNotSquare
+	}
+
+	a := dense(m)
+	h := make([][]float64, n)
+	for i := range h {
+		h[i] = a[i*n : (i+1)*n]
+	}
+
+	var v [][]float64
+	if wantVectors {
+		v = make([][]float64, n)
+		for i := range v {
+			v[i] = make([]float64, n)
+		}
+	}
+
+	d := make([]float64, n)
+	e := make([]float64, n)
+	if n > 0 {
+		hessenberg(h, v)
+		if err := schur(h, v, d, e); err != nil {
+			return nil, err
+		}
+	}
+
+	values := make([]complex128, n)
+	for i := range values {
+		values[i] = complex(d[i], e[i])
+	}
+
+	result := &GeneralDecomposition{Values: values}
+	if !wantVectors {
+		return result, nil
+	}
+
+	result.Vectors = make([][]complex128, n)
+	for j := 0; j < n; j++ {
+		vec := make([]complex128, n)
+		for i := 0; i < n; i++ {
+			switch {
+			case e[j] > 0:
+				vec[i] = complex(v[i][j], v[i][j+1])
+			case e[j] < 0:
+				vec[i] = complex(v[i][j-1], -v[i][j])
+			default:
+				vec[i] = complex(v[i][j], 0)
+			}
+		}
+
+		var norm float64
+		for _, x := range vec {
+			norm = math.Hypot(norm, cmplx.Abs(x))
+		}
+		if norm != 0 {
+			for i := range vec {
+				vec[i] /= complex(norm, 0)
+			}
+		}
+		result.Vectors[j] = vec
+	}
+
+	return result, nil
+}
+
+// hessenberg reduces the square matrix held in h to upper Hessenberg form using orthogonal
+// similarity transformations (EISPACK orthes). When v is not nil the transformation is
+// accumulated into it.
+func hessenberg(h, v [][]float64) {
+	n := len(h)
+	high := n - 1
+	ort := make([]float64, n)
+
+	for m := 1; m <= high-1; m++ {
+		var scale float64
+		for i := m; i <= high; i++ {
+			scale += math.Abs(h[i][m-1])
+		}
+		if scale == 0 {
+			continue
+		}
+
+		// Compute the Householder transformation.
+		var hh float64
+		for i := high; i >= m; i-- {
+			ort[i] = h[i][m-1] / scale
+			hh += ort[i] * ort[i]
+		}
+		g := math.Sqrt(hh)
+		if ort[m] > 0 {
+			g = -g
+		}
+		hh -= ort[m] * g
+		ort[m] -= g
+
+		// Apply it as H = (I - u*uᵀ/h) * H * (I - u*uᵀ/h).
+		for j := m; j < n; j++ {
+			var f float64
+			for i := high; i >= m; i-- {
+				f += ort[i] * h[i][j]
+			}
+			f /= hh
+			for i := m; i <= high; i++ {
+				h[i][j] -= f * ort[i]
+			}
+		}
+		for i := 0; i <= high; i++ {
+			var f float64
+			for j := high; j >= m; j-- {
+				f += ort[j] * h[i][j]
+			}
+			f /= hh
+			for j := m; j <= high; j++ {
+				h[i][j] -= f * ort[j]
+			}
+		}
+		ort[m] *= scale
+		h[m][m-1] = scale * g
+	}
+
+	if v == nil {
+		return
+	}
+
+	// Accumulate the transformations.
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			v[i][j] = 0
+		}
+		v[i][i] = 1
+	}
+	for m := high - 1; m >= 1; m-- {
+		if h[m][m-1] == 0 {
+			continue
+		}
+		for i := m + 1; i <= high; i++ {
+			ort[i] = h[i][m-1]
+		}
+		for j := m; j <= high; j++ {
+			var g float64
+			for i := m; i <= high; i++ {
+				g += ort[i] * v[i][j]
+			}
+			// Double division avoids possible underflow.
+			g = (g / ort[m]) / h[m][m-1]
+			for i := m; i <= high; i++ {
+				v[i][j] += g * ort[i]
+			}
+		}
+	}
+}
+
+// schur reduces the upper Hessenberg matrix held in h to real Schur form with the Francis
+// double-shift QR algorithm (EISPACK hqr2), storing the real and imaginary parts of the
+// eigenvalues in d and e. When v is not nil it must hold the Hessenberg transformation and
+// is overwritten with the real eigenvector representation: a real eigenvalue j owns column j,
+// and a conjugate pair j, j+1 owns the real and imaginary parts in columns j and j+1.
+func schur(h, v [][]float64, d, e []float64) error {
+	nn := len(h)
+	n := nn - 1
+	low, high := 0, nn-1
+	var exshift, p, q, r, s, z, t, w, x, y float64
+
+	var norm float64
+	for i := 0; i < nn; i++ {
+		for j := max(i-1, 0); j < nn; j++ {
+			norm += math.Abs(h[i][j])
+		}
+	}
+
+	iter := 0
+	for n >= low {
+		// Look for a single small sub-diagonal element.
+		l := n
+		for l > low {
+			s = math.Abs(h[l-1][l-1]) + math.Abs(h[l][l])
+			if s == 0 {
+				s = norm
+			}
+			// Deflate on <= so that exact zeros split the matrix even when its norm is zero.
+			if math.Abs(h[l][l-1]) <= epsilon*s {
+				break
+			}
+			l--
+		}
+
+		switch {
+		case l == n:
+			// One root found.
+			h[n][n] += exshift
+			d[n] = h[n][n]
+			e[n] = 0
+			n--
+			iter = 0
+
+		case l == n-1:
+			// Two roots found.
+			w = h[n][n-1] * h[n-1][n]
+			p = (h[n-1][n-1] - h[n][n]) / 2
+			q = p*p + w
+			z = math.Sqrt(math.Abs(q))
+			h[n][n] += exshift
+			h[n-1][n-1] += exshift
+			x = h[n][n]
+
+			if q >= 0 {
+				// Real pair.
+				if p >= 0 {
+					z = p + z
+				} else {
+					z = p - z
+				}
+				d[n-1] = x + z
+				d[n] = d[n-1]
+				if z != 0 {
+					d[n] = x - w/z
+				}
+				e[n-1] = 0
+				e[n] = 0
+				x = h[n][n-1]
+				s = math.Abs(x) + math.Abs(z)
+				if s != 0 {
+					// Rotate the block into upper triangular form, unless it already is.
+					p = x / s
+					q = z / s
+					r = math.Sqrt(p*p + q*q)
+					p /= r
+					q /= r
+
+					// Row modification.
+					for j := n - 1; j < nn; j++ {
+						z = h[n-1][j]
+						h[n-1][j] = q*z + p*h[n][j]
+						h[n][j] = q*h[n][j] - p*z
+					}
+
+					// Column modification.
+					for i := 0; i <= n; i++ {
+						z = h[i][n-1]
+						h[i][n-1] = q*z + p*h[i][n]
+						h[i][n] = q*h[i][n] - p*z
+					}
+
+					// Accumulate transformations.
+					if v != nil {
+						for i := low; i <= high; i++ {
+							z = v[i][n-1]
+							v[i][n-1] = q*z + p*v[i][n]
+							v[i][n] = q*v[i][n] - p*z
+						}
+					}
+				}
+			} else {
+				// Complex pair.
+				d[n-1] = x + p
+				d[n] = x + p
+				e[n-1] = z
+				e[n] = -z
+			}
+			n -= 2
+			iter = 0
+
+		default:
+			// No convergence yet, form the shift.
+			x = h[n][n]
+			y = 0
+			w = 0
+			if l < n {
+				y = h[n-1][n-1]
+				w = h[n][n-1] * h[n-1][n]
+			}
+
+			// Wilkinson's original ad hoc shift.
+			if iter == 10 {
+				exshift += x
+				for i := low; i <= n; i++ {
+					h[i][i] -= x
+				}
+				s = math.Abs(h[n][n-1]) + math.Abs(h[n-1][n-2])
+				x = 0.75 * s
+				y = x
+				w = -0.4375 * s * s
+			}
+
+			// MATLAB's ad hoc shift.
+			if iter == 30 {
+				s = (y - x) / 2
+				s = s*s + w
+				if s > 0 {
+					s = math.Sqrt(s)
+					if y < x {
+						s = -s
+					}
+					s = x - w/((y-x)/2+s)
+					for i := low; i <= n; i++ {
+						h[i][i] -= s
+					}
+					exshift += s
+					x = 0.964
+					y = x
+					w = x
+				}
+			}
+
+			iter++
+			if iter > maxFrancisIterations {
+				return algebra.ErrNoConvergence
+			}
+
+			// Look for two consecutive small sub-diagonal elements.
+			m := n - 2
+			for m >= l {
+				z = h[m][m]
+				r = x - z
+				s = y - z
+				p = (r*s-w)/h[m+1][m] + h[m][m+1]
+				q = h[m+1][m+1] - z - r - s
+				r = h[m+2][m+1]
+				s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+				p /= s
+				q /= s
+				r /= s
+				if m == l {
+					break
+				}
+				if math.Abs(h[m][m-1])*(math.Abs(q)+math.Abs(r)) <
+					epsilon*(math.Abs(p)*(math.Abs(h[m-1][m-1])+math.Abs(z)+math.Abs(h[m+1][m+1]))) {
+					break
+				}
+				m--
+			}
+
+			for i := m + 2; i <= n; i++ {
+				h[i][i-2] = 0
+				if i > m+2 {
+					h[i][i-3] = 0
+				}
+			}
+
+			// Double QR step involving rows l:n and columns m:n.
+			for k := m; k <= n-1; k++ {
+				notlast := k != n-1
+				if k != m {
+					p = h[k][k-1]
+					q = h[k+1][k-1]
+					r = 0
+					if notlast {
+						r = h[k+2][k-1]
+					}
+					x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+					if x == 0 {
+						continue
+					}
+					p /= x
+					q /= x
+					r /= x
+				}
+
+				s = math.Sqrt(p*p + q*q + r*r)
+				if p < 0 {
+					s = -s
+				}
+				if s == 0 {
+					continue
+				}
+
+				if k != m {
+					h[k][k-1] = -s * x
+				} else if l != m {
+					h[k][k-1] = -h[k][k-1]
+				}
+				p += s
+				x = p / s
+				y = q / s
+				z = r / s
+				q /= p
+				r /= p
+
+				// Row modification.
+				for j := k; j < nn; j++ {
+					p = h[k][j] + q*h[k+1][j]
+					if notlast {
+						p += r * h[k+2][j]
+						h[k+2][j] -= p * z
+					}
+					h[k][j] -= p * x
+					h[k+1][j] -= p * y
+				}
+
+				// Column modification.
+				for i := 0; i <= min(n, k+3); i++ {
+					p = x*h[i][k] + y*h[i][k+1]
+					if notlast {
+						p += z * h[i][k+2]
+						h[i][k+2] -= p * r
+					}
+					h[i][k] -= p
+					h[i][k+1] -= p * q
+				}
+
+				// Accumulate transformations.
+				if v != nil {
+					for i := low; i <= high; i++ {
+						p = x*v[i][k] + y*v[i][k+1]
+						if notlast {
+							p += z * v[i][k+2]
+							v[i][k+2] -= p * r
+						}
+						v[i][k] -= p
+						v[i][k+1] -= p * q
+					}
+				}
+			}
+		}
+	}
+
+	if v == nil || norm == 0 {
+		return nil
+	}
+
+	// Back-substitute to find the vectors of the upper triangular form.
+	for n = nn - 1; n >= 0; n-- {
+		p = d[n]
+		q = e[n]
+
+		switch {
+		case q == 0:
+			// Real vector.
+			l := n
+			h[n][n] = 1
+			for i := n - 1; i >= 0; i-- {
+				w = h[i][i] - p
+				r = 0
+				for j := l; j <= n; j++ {
+					r += h[i][j] * h[j][n]
+				}
+				if e[i] < 0 {
+					z = w
+					s = r
+					continue
+				}
+
+				l = i
+				if e[i] == 0 {
+					if w != 0 {
+						h[i][n] = -r / w
+					} else {
+						h[i][n] = -r / (epsilon * norm)
+					}
+				} else {
+					// Solve the real equations.
+					x = h[i][i+1]
+					y = h[i+1][i]
+					q = (d[i]-p)*(d[i]-p) + e[i]*e[i]
+					t = (x*s - z*r) / q
+					h[i][n] = t
+					if math.Abs(x) > math.Abs(z) {
+						h[i+1][n] = (-r - w*t) / x
+					} else {
+						h[i+1][n] = (-s - y*t) / z
+					}
+				}
+
+				// Overflow control.
+				t = math.Abs(h[i][n])
+				if (epsilon*t)*t > 1 {
+					for j := i; j <= n; j++ {
+						h[j][n] /= t
+					}
+				}
+			}
+
+		case q < 0:
+			// Complex vector, the last component is chosen imaginary so the system is triangular.
+			l := n - 1
+			if math.Abs(h[n][n-1]) > math.Abs(h[n-1][n]) {
+				h[n-1][n-1] = q / h[n][n-1]
+				h[n-1][n] = -(h[n][n] - p) / h[n][n-1]
+			} else {
+				c := complex(0, -h[n-1][n]) / complex(h[n-1][n-1]-p, q)
+				h[n-1][n-1] = real(c)
+				h[n-1][n] = imag(c)
+			}
+			h[n][n-1] = 0
+			h[n][n] = 1
+
+			var ra, sa float64
+			for i := n - 2; i >= 0; i-- {
+				ra = 0
+				sa = 0
+				for j := l; j <= n; j++ {
+					ra += h[i][j] * h[j][n-1]
+					sa += h[i][j] * h[j][n]
+				}
+				w = h[i][i] - p
+
+				if e[i] < 0 {
+					z = w
+					r = ra
+					s = sa
+					continue
+				}
+
+				l = i
+				if e[i] == 0 {
+					c := complex(-ra, -sa) / complex(w, q)
+					h[i][n-1] = real(c)
+					h[i][n] = imag(c)
+				} else {
+					// Solve the complex equations.
+					x = h[i][i+1]
+					y = h[i+1][i]
+					vr := (d[i]-p)*(d[i]-p) + e[i]*e[i] - q*q
+					vi := (d[i] - p) * 2 * q
+					if vr == 0 && vi == 0 {
+						vr = epsilon * norm * (math.Abs(w) + math.Abs(q) + math.Abs(x) + math.Abs(y) + math.Abs(z))
+					}
+					c := complex(x*r-z*ra+q*sa, x*s-z*sa-q*ra) / complex(vr, vi)
+					h[i][n-1] = real(c)
+					h[i][n] = imag(c)
+					if math.Abs(x) > math.Abs(z)+math.Abs(q) {
+						h[i+1][n-1] = (-ra - w*h[i][n-1] + q*h[i][n]) / x
+						h[i+1][n] = (-sa - w*h[i][n] - q*h[i][n-1]) / x
+					} else {
+						c := complex(-r-y*h[i][n-1], -s-y*h[i][n]) / complex(z, q)
+						h[i+1][n-1] = real(c)
+						h[i+1][n] = imag(c)
+					}
+				}
+
+				// Overflow control.
+				t = max(math.Abs(h[i][n-1]), math.Abs(h[i][n]))
+				if (epsilon*t)*t > 1 {
+					for j := i; j <= n; j++ {
+						h[j][n-1] /= t
+						h[j][n] /= t
+					}
+				}
+			}
+		}
+	}
+
+	// Back transformation to get the eigenvectors of the original matrix.
+	for j := nn - 1; j >= low; j-- {
+		for i := low; i <= high; i++ {
+			z = 0
+			for k := low; k <= min(j, high); k++ {
+				z += v[i][k] * h[k][j]
+			}
+			v[i][j] = z
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package eigen
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+func sortComplex(values []complex128) []complex128 {
+	sorted := append([]complex128(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if real(sorted[i]) != real(sorted[j]) {
+			return real(sorted[i]) < real(sorted[j])
+		}
+		return imag(sorted[i]) < imag(sorted[j])
+	})
+	return sorted
+}
+
+func TestGeneral(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       [][]float64
+		wantValues []complex128
+		wantErr    error
+	}{
+		{
+			name: "Test eigen decomposition of rotation matrix",
+			data: [][]float64{
+				{0, -1},
+				{1, 0},
+			},
+			wantValues: []complex128{-1i, 1i},
+		},
+		{
+			name: "Test eigen decomposition of upper triangular matrix",
+			data: [][]float64{
+				{1, 2, 3},
+				{0, 4, 5},
+				{0, 0, 6},
+			},
+			wantValues: []complex128{1, 4, 6},
+		},
+		{
+			name: "Test eigen decomposition of non-symmetric matrix with real eigenvalues",
+			data: [][]float64{
+				{4, 1},
+				{2, 3},
+			},
+			wantValues: []complex128{2, 5},
+		},
+		{
+			name: "Test eigen decomposition of companion matrix with complex eigenvalues",
+			data: [][]float64{
+				{0, 0, 0, -4},
+				{1, 0, 0, 0},
+				{0, 1, 0, 0},
+				{0, 0, 1, 0},
+			},
+			wantValues: []complex128{
+				complex(-1, -1), complex(-1, 1), complex(1, -1), complex(1, 1),
+			},
+		},
+		{
+			name: "Test eigen decomposition of transition matrix",
+			data: [][]float64{
+				{0.5, 0.5},
+				{0.2, 0.8},
+			},
+			wantValues: []complex128{0.3, 1},
+		},
+		{
+			name: "Test eigen decomposition of 2x2 zero matrix",
+			data: [][]float64{
+				{0, 0},
+				{0, 0},
+			},
+			wantValues: []complex128{0, 0},
+		},
+		{
+			name: "Test eigen decomposition of 4x4 zero matrix",
+			data: [][]float64{
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+			wantValues: []complex128{0, 0, 0, 0},
+		},
+		{
+			name: "Test eigen decomposition of strictly upper triangular matrix",
+			data: [][]float64{
+				{0, 1, 2},
+				{0, 0, 3},
+				{0, 0, 0},
+			},
+			wantValues: []complex128{0, 0, 0},
+		},
+		{
+			name: "Test eigen decomposition of nilpotent shift matrix",
+			data: [][]float64{
+				{0, 0},
+				{1, 0},
+			},
+			wantValues: []complex128{0, 0},
+		},
+		{
+			name: "Test eigen decomposition of rectangular matrix should return error",
+			data: [][]float64{
+				{1, 2},
+			},
+			wantErr: algebra.ErrNotSquare,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustMatrix(t, tt.data)
+			got, err := General(a)
+			if err != tt.wantErr {
+				t.Fatalf("General() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			n := a.Rows()
+			if len(tt.wantValues) > 0 {
+				values := sortComplex(got.Values)
+				for i := range tt.wantValues {
+					if cmplx.Abs(values[i]-tt.wantValues[i]) > tolerance {
+						t.Errorf("General() Values = %v, want %v", values, tt.wantValues)
+						break
+					}
+				}
+			}
+
+			for j, lambda := range got.Values {
+				vec := got.Vectors[j]
+				var norm float64
+				for _, x := range vec {
+					norm = math.Hypot(norm, cmplx.Abs(x))
+				}
+				if !(math.Abs(norm-1) <= tolerance) {
+					t.Errorf("General() Vectors[%d] has norm %v, want 1", j, norm)
+				}
+				for i := 0; i < n; i++ {
+					var av complex128
+					for k := 0; k < n; k++ {
+						av += complex(a.MustAt(i, k), 0) * vec[k]
+					}
+					if !(cmplx.Abs(av-lambda*vec[i]) <= tolerance) {
+						t.Errorf("General() A*v != λ*v for λ = %v", lambda)
+						break
+					}
+				}
+			}
+
+			values, err := GeneralValues(a)
+			if err != nil {
+				t.Fatalf("GeneralValues() error = %v", err)
+			}
+			for i := range values {
+				if cmplx.Abs(values[i]-got.Values[i]) > tolerance {
+					t.Errorf("GeneralValues() = %v, want %v", values, got.Values)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestGeneral_Nil(t *testing.T) {
+	if _, err := General(nil); err != algebra.ErrNilMatrix {
+		t.Errorf("General() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+	if _, err := GeneralValues(nil); err != algebra.ErrNilMatrix {
+		t.Errorf("GeneralValues() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+}
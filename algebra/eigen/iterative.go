@@ -0,0 +1,400 @@
+package eigen
+
+import (
+	"math"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+const (
+	// defaultIterativeTolerance is the relative residual the iterative solvers stop at when
+	// IterativeSettings.Tolerance is not set.
+	defaultIterativeTolerance = 1e-10
+
+	// defaultRestart is the Krylov subspace size GMRES restarts at when
+	// IterativeSettings.Restart is not set.
+	defaultRestart = 30
+)
+
+// IterativeSettings configures the iterative solvers. The zero value selects the defaults.
+type IterativeSettings struct {
+	// Tolerance is the relative residual ‖b - A*x‖ / ‖b‖ at which the solver stops.
+	// Zero selects 1e-10.
+	Tolerance float64
+
+	// MaxIterations bounds the number of iterations. Zero selects 10 times the size of the system.
+	MaxIterations int
+
+	// Restart is the number of iterations after which GMRES restarts. Zero selects 30.
+	// It is ignored by the other solvers.
+	Restart int
+
+	// X0 is the initial guess. Nil starts from the zero vector.
+	X0 []float64
+
+	// Preconditioner, if set, is applied to accelerate convergence.
+	Preconditioner Preconditioner
+
+	// Callback, if set, is called after every iteration with the iteration number and the
+	// current relative residual. Returning false stops the solver.
+	Callback func(iteration int, residual float64) bool
+}
+
+// IterativeResult holds the outcome of an iterative solve.
+type IterativeResult struct {
+	// X is the approximate solution.
+	X []float64
+
+	// Iterations is the number of iterations performed.
+	Iterations int
+
+	// Residual is the relative residual ‖b - A*x‖ / ‖b‖ of X, as tracked by the solver.
+	Residual float64
+
+	// Converged reports whether Residual reached the tolerance.
+	Converged bool
+}
+
+// CG solves A*x = b for a symmetric positive-definite matrix A with the (preconditioned)
+// Conjugate Gradient method. A is only accessed through matrix-vector products, so sparse
+// matrices work as well as dense ones. The preconditioner must be symmetric positive-definite too.
+// Returns algebra.ErrNotPositiveDefinite when A turns out not to be positive-definite and
+// algebra.ErrNoConvergence, together with the last iterate, when the tolerance is not met
+// within the iteration limit.
+func CG(a algebra.Matrix, b []float64, settings *IterativeSettings) (*IterativeResult, error) {
+	s, err := newKrylov(a, b, settings)
+	if err != nil {
+		return nil, err
+	}
+	if s.converged {
+		return s.result()
+	}
+
+	z := s.precondition(s.r)
+	p := append([]float64(nil), z...)
+	rz := s.backend.Dot(s.r, z)
+
+	for s.iterations < s.maxIterations {
+		ap := s.mulVec(p)
+		pap := s.backend.Dot(p, ap)
+		if pap <= 0 {
+			return nil, algebra.ErrNotPositiveDefinite
+		}
+
+		alpha := rz / pap
+		s.backend.Axpy(alpha, p, s.x)
+		s.backend.Axpy(-alpha, ap, s.r)
+		if s.step(algebra.Vector(s.r).Norm()) {
+			break
+		}
+
+		z = s.precondition(s.r)
+		rzNext := s.backend.Dot(s.r, z)
+		beta := rzNext / rz
+		rz = rzNext
+		for i := range p {
+			p[i] = z[i] + beta*p[i]
+		}
+	}
+	return s.result()
+}
+
+// BiCGSTAB solves A*x = b for a general square matrix A with the right-preconditioned
+// Biconjugate Gradient Stabilized method. A is only accessed through matrix-vector products.
+// Returns algebra.ErrNoConvergence, together with the last iterate, when the tolerance is
+// not met within the iteration limit or the method breaks down, as it does on singular systems.
+func BiCGSTAB(a algebra.Matrix, b []float64, settings *IterativeSettings) (*IterativeResult, error) {
+	s, err := newKrylov(a, b, settings)
+	if err != nil {
+		return nil, err
+	}
+	if s.converged {
+		return s.result()
+	}
+
+	n := len(b)
+	rHat := append([]float64(nil), s.r...)
+	p, v := make([]float64, n), make([]float64, n)
+	rho, alpha, omega := 1.0, 1.0, 1.0
+
+	for s.iterations < s.maxIterations {
+		rhoNext := s.backend.Dot(rHat, s.r)
+		if breakdown(rhoNext) || breakdown(omega) {
+			break
+		}
+
+		beta := rhoNext / rho * alpha / omega
+		rho = rhoNext
+		for i := range p {
+			p[i] = s.r[i] + beta*(p[i]-omega*v[i])
+		}
+
+		pHat := s.precondition(p)
+		v = s.mulVec(pHat)
+		rv := s.backend.Dot(rHat, v)
+		if breakdown(rv) {
+			break
+		}
+		alpha = rho / rv
+
+		// s = r - alpha*v is kept in r.
+		s.backend.Axpy(-alpha, v, s.r)
+		s.backend.Axpy(alpha, pHat, s.x)
+		if rNorm := algebra.Vector(s.r).Norm(); rNorm <= s.tolerance*s.bNorm {
+			s.step(rNorm)
+			break
+		}
+
+		sHat := s.precondition(s.r)
+		t := s.mulVec(sHat)
+		tt := s.backend.Dot(t, t)
+		if breakdown(tt) {
+			break
+		}
+
+		omega = s.backend.Dot(t, s.r) / tt
+		s.backend.Axpy(omega, sHat, s.x)
+		s.backend.Axpy(-omega, t, s.r)
+		if s.step(algebra.Vector(s.r).Norm()) {
+			break
+		}
+	}
+	return s.result()
+}
+
+// GMRES solves A*x = b for a general square matrix A with the restarted, right-preconditioned
+// Generalized Minimal Residual method. Every cycle builds an orthonormal Krylov basis of
+// Restart vectors with modified Gram-Schmidt and minimizes the residual over it through Givens
+// rotations. A is only accessed through matrix-vector products.
+// Returns algebra.ErrNoConvergence, together with the last iterate, when the tolerance is
+// not met within the iteration limit or the Krylov basis stops growing on a singular system.
+func GMRES(a algebra.Matrix, b []float64, settings *IterativeSettings) (*IterativeResult, error) {
+	s, err := newKrylov(a, b, settings)
+	if err != nil {
+		return nil, err
+	}
+	if s.converged {
+		return s.result()
+	}
+
+	n := len(b)
+	m := defaultRestart
+	if settings != nil && settings.Restart > 0 {
+		m = settings.Restart
+	}
+	m = min(m, n)
+
+	v := make([][]float64, m+1)
+	h := make([][]float64, m+1)
+	for i := range h {
+		h[i] = make([]float64, m)
+	}
+	cs, sn, g := make([]float64, m), make([]float64, m), make([]float64, m+1)
+
+	singular := false
+	for !s.stopped && !singular && s.iterations < s.maxIterations {
+		beta := algebra.Vector(s.r).Norm()
+		v[0] = algebra.Vector(s.r).Scale(1 / beta)
+		clear(g)
+		g[0] = beta
+
+		k := 0
+		for k < m && s.iterations < s.maxIterations {
+			w := s.mulVec(s.precondition(v[k]))
+			for i := 0; i <= k; i++ {
+				h[i][k] = s.backend.Dot(w, v[i])
+				s.backend.Axpy(-h[i][k], v[i], w)
+			}
+			h[k+1][k] = algebra.Vector(w).Norm()
+			exhausted := h[k+1][k] == 0
+			if !exhausted {
+				v[k+1] = algebra.Vector(w).Scale(1 / h[k+1][k])
+			}
+
+			// Apply the previous rotations to the new column, then annihilate h[k+1][k].
+			for i := 0; i < k; i++ {
+				h[i][k], h[i+1][k] = cs[i]*h[i][k]+sn[i]*h[i+1][k], -sn[i]*h[i][k]+cs[i]*h[i+1][k]
+			}
+			r := math.Hypot(h[k][k], h[k+1][k])
+			if breakdown(r) {
+				// A*M⁻¹*v[k] lies in the span of the basis: A is singular. Drop the column and
+				// minimize over the basis built so far.
+				singular = true
+				break
+			}
+			cs[k], sn[k] = h[k][k]/r, h[k+1][k]/r
+			h[k][k], h[k+1][k] = r, 0
+			g[k], g[k+1] = cs[k]*g[k], -sn[k]*g[k]
+
+			k++
+			if s.step(math.Abs(g[k])) || exhausted {
+				break
+			}
+		}
+
+		// Solve the k x k upper-triangular system H*y = g and update x += M⁻¹*V*y.
+		y := make([]float64, k)
+		finite := true
+		for i := k - 1; i >= 0; i-- {
+			sum := g[i]
+			for j := i + 1; j < k; j++ {
+				sum -= h[i][j] * y[j]
+			}
+			y[i] = sum / h[i][i]
+			finite = finite && !math.IsNaN(y[i]) && !math.IsInf(y[i], 0)
+		}
+		if !finite {
+			// Keep the last finite iterate rather than spreading NaN or Inf into it.
+			break
+		}
+
+		u := make([]float64, n)
+		for i, yi := range y {
+			s.backend.Axpy(yi, v[i], u)
+		}
+		s.backend.Axpy(1, s.precondition(u), s.x)
+
+		// Recompute the true residual to restart from, or to report on exit.
+		s.r = s.residual()
+		s.res = algebra.Vector(s.r).Norm() / s.bNorm
+		s.converged = s.res <= s.tolerance
+		if s.converged {
+			break
+		}
+	}
+	return s.result()
+}
+
+// krylov holds the state shared by the iterative solvers.
+type krylov struct {
+	a             algebra.Matrix
+	b             []float64
+	x             []float64
+	r             []float64
+	bNorm         float64
+	tolerance     float64
+	maxIterations int
+	m             Preconditioner
+	callback      func(iteration int, residual float64) bool
+	backend       algebra.Backend
+
+	iterations int
+	res        float64
+	converged  bool
+	stopped    bool
+}
+
+// newKrylov validates the system A*x = b and computes the initial residual.
+func newKrylov(a algebra.Matrix, b []float64, settings *IterativeSettings) (*krylov, error) {
+	if a == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	n := a.Rows()
+	if a.Cols() != n {
+		return nil, algebra.ErrNotSquare
+	}
+	if len(b) != n {
+		return nil, algebra.ErrInvalidDimensions
+	}
+
+	if settings == nil {
+		settings = &IterativeSettings{}
+	}
+
+	_, backend := algebra.CurrentBackend()
+	s := &krylov{
+		a:             a,
+		b:             b,
+		x:             make([]float64, n),
+		bNorm:         algebra.Vector(b).Norm(),
+		tolerance:     settings.Tolerance,
+		maxIterations: settings.MaxIterations,
+		m:             settings.Preconditioner,
+		callback:      settings.Callback,
+		backend:       backend,
+	}
+	if s.tolerance <= 0 {
+		s.tolerance = defaultIterativeTolerance
+	}
+	if s.maxIterations <= 0 {
+		s.maxIterations = 10 * n
+	}
+
+	if settings.X0 != nil {
+		if len(settings.X0) != n {
+			return nil, algebra.ErrInvalidDimensions
+		}
+		copy(s.x, settings.X0)
+	}
+
+	// The solution of A*x = 0 is x = 0, whatever the initial guess.
+	if s.bNorm == 0 {
+		clear(s.x)
+		s.converged = true
+		return s, nil
+	}
+
+	s.r = s.residual()
+	s.res = algebra.Vector(s.r).Norm() / s.bNorm
+	s.converged = s.res <= s.tolerance
+	return s, nil
+}
+
+// step records the end of an iteration whose residual has norm rNorm and reports whether
+// the solver must stop, either because it converged or because the callback said so.
+func (s *krylov) step(rNorm float64) bool {
+	s.iterations++
+	s.res = rNorm / s.bNorm
+	s.converged = s.res <= s.tolerance
+	if s.callback != nil && !s.callback(s.iterations, s.res) {
+		s.stopped = true
+	}
+	return s.converged || s.stopped
+}
+
+// result returns the outcome of the solve, with algebra.ErrNoConvergence when the solver
+// gave up before meeting the tolerance for any reason other than the callback.
+func (s *krylov) result() (*IterativeResult, error) {
+	result := &IterativeResult{
+		X:          s.x,
+		Iterations: s.iterations,
+		Residual:   s.res,
+		Converged:  s.converged,
+	}
+	if !s.converged && !s.stopped {
+		return result, algebra.ErrNoConvergence
+	}
+	return result, nil
+}
+
+// mulVec returns A*x, through the MulVec method of A when it has one.
+func (s *krylov) mulVec(x []float64) []float64 {
+	y, _ := algebra.MulVec(s.a, x)
+	return y
+}
+
+// residual returns b - A*x for the current iterate.
+func (s *krylov) residual() []float64 {
+	r := s.mulVec(s.x)
+	for i := range r {
+		r[i] = s.b[i] - r[i]
+	}
+	return r
+}
+
+// precondition returns M⁻¹*r, or a copy of r without a preconditioner.
+func (s *krylov) precondition(r []float64) []float64 {
+	z := make([]float64, len(r))
+	if s.m == nil {
+		copy(z, r)
+		return z
+	}
+	s.m.Apply(z, r)
+	return z
+}
+
+// breakdown reports whether d is unusable as a denominator: zero, infinite or NaN.
+func breakdown(d float64) bool {
+	return d == 0 || math.IsNaN(d) || math.IsInf(d, 0)
+}
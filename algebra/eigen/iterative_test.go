@@ -0,0 +1,229 @@
+package eigen
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+// poisson returns the 5-point finite-difference Laplacian on a g x g grid in CSR format,
+// plus convection times a first-order upwind difference along x, which makes it non-symmetric.
+func poisson(t *testing.T, g int, convection float64) algebra.Matrix {
+	t.Helper()
+	var rows, cols []int
+	var data []float64
+	add := func(i, j int, v float64) {
+		rows, cols, data = append(rows, i), append(cols, j), append(data, v)
+	}
+	for y := 0; y < g; y++ {
+		for x := 0; x < g; x++ {
+			i := y*g + x
+			add(i, i, 4+convection)
+			if x > 0 {
+				add(i, i-1, -1-convection)
+			}
+			if x < g-1 {
+				add(i, i+1, -1)
+			}
+			if y > 0 {
+				add(i, i-g, -1)
+			}
+			if y < g-1 {
+				add(i, i+g, -1)
+			}
+		}
+	}
+	coo, err := algebra.NewCOO(g*g, g*g, rows, cols, data)
+	if err != nil {
+		t.Fatalf("NewCOO() error = %v", err)
+	}
+	csr, err := algebra.ToCSR(coo)
+	if err != nil {
+		t.Fatalf("ToCSR() error = %v", err)
+	}
+	return csr
+}
+
+func TestIterativeSolvers(t *testing.T) {
+	type solver func(algebra.Matrix, []float64, *IterativeSettings) (*IterativeResult, error)
+	solvers := []struct {
+		name      string
+		solve     solver
+		symmetric bool
+	}{
+		{name: "CG", solve: CG, symmetric: true},
+		{name: "GMRES", solve: GMRES},
+		{name: "BiCGSTAB", solve: BiCGSTAB},
+	}
+	preconditioners := []struct {
+		name string
+		new  func(algebra.Matrix) (Preconditioner, error)
+	}{
+		{name: "no", new: func(algebra.Matrix) (Preconditioner, error) { return nil, nil }},
+		{name: "Jacobi", new: JacobiPreconditioner},
+		{name: "ILU(0)", new: ILUPreconditioner},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, sv := range solvers {
+		convection := 0.0
+		if !sv.symmetric {
+			convection = 0.5
+		}
+		sparse := poisson(t, 10, convection)
+		dense, _ := algebra.ToFlat(sparse)
+
+		for _, a := range []algebra.Matrix{sparse, dense} {
+			for _, pc := range preconditioners {
+				name := fmt.Sprintf("Test %s with %s preconditioner on %T", sv.name, pc.name, a)
+				t.Run(name, func(t *testing.T) {
+					want := make([]float64, a.Rows())
+					for i := range want {
+						want[i] = r.Float64()*2 - 1
+					}
+					b, err := algebra.MulVec(a, want)
+					if err != nil {
+						t.Fatalf("MulVec() error = %v", err)
+					}
+
+					m, err := pc.new(a)
+					if err != nil {
+						t.Fatalf("preconditioner error = %v", err)
+					}
+					settings := &IterativeSettings{Tolerance: 1e-12, Restart: 20, Preconditioner: m}
+					got, err := sv.solve(a, b, settings)
+					if err != nil {
+						t.Fatalf("%s() error = %v", sv.name, err)
+					}
+					if !got.Converged || got.Residual > 1e-12 || got.Iterations == 0 {
+						t.Errorf("%s() = %+v", sv.name, got)
+					}
+					for i := range want {
+						if math.Abs(got.X[i]-want[i]) > 1e-8 {
+							t.Errorf("%s() X[%d] = %v, want %v", sv.name, i, got.X[i], want[i])
+							break
+						}
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestIterativeSolvers_Settings(t *testing.T) {
+	a := poisson(t, 6, 0)
+	b := make([]float64, a.Rows())
+	for i := range b {
+		b[i] = 1
+	}
+
+	for name, solve := range map[string]func(algebra.Matrix, []float64, *IterativeSettings) (*IterativeResult, error){
+		"CG":       CG,
+		"GMRES":    GMRES,
+		"BiCGSTAB": BiCGSTAB,
+	} {
+		t.Run(fmt.Sprintf("Test %s settings", name), func(t *testing.T) {
+			exact, err := solve(a, b, nil)
+			if err != nil {
+				t.Fatalf("%s() error = %v", name, err)
+			}
+
+			got, err := solve(a, b, &IterativeSettings{MaxIterations: 2, Restart: 1})
+			if err != algebra.ErrNoConvergence {
+				t.Errorf("%s() error = %v, want %v", name, err, algebra.ErrNoConvergence)
+			}
+			if got == nil || got.Converged || got.Iterations != 2 {
+				t.Errorf("%s() with 2 iterations = %+v", name, got)
+			}
+
+			var calls []int
+			got, err = solve(a, b, &IterativeSettings{
+				Callback: func(iteration int, residual float64) bool {
+					calls = append(calls, iteration)
+					return iteration < 3
+				},
+			})
+			if err != nil {
+				t.Errorf("%s() error = %v, want nil when stopped by the callback", name, err)
+			}
+			if got.Converged || got.Iterations != 3 || fmt.Sprint(calls) != "[1 2 3]" {
+				t.Errorf("%s() stopped by callback = %+v after calls %v", name, got, calls)
+			}
+
+			got, err = solve(a, b, &IterativeSettings{X0: exact.X})
+			if err != nil || !got.Converged || got.Iterations != 0 {
+				t.Errorf("%s() from the solution = %+v, %v", name, got, err)
+			}
+
+			x0 := []float64{1}
+			if _, err := solve(a, b, &IterativeSettings{X0: x0}); err != algebra.ErrInvalidDimensions {
+				t.Errorf("%s() error = %v, want %v", name, err, algebra.ErrInvalidDimensions)
+			}
+
+			got, err = solve(a, make([]float64, a.Rows()), &IterativeSettings{X0: b})
+			if err != nil || !got.Converged || algebra.Vector(got.X).Norm() != 0 {
+				t.Errorf("%s() with zero right-hand side = %+v, %v", name, got, err)
+			}
+
+			if _, err := solve(nil, b, nil); err != algebra.ErrNilMatrix {
+				t.Errorf("%s() error = %v, want %v", name, err, algebra.ErrNilMatrix)
+			}
+			if _, err := solve(mustMatrix(t, [][]float64{{1, 2}}), []float64{1}, nil); err != algebra.ErrNotSquare {
+				t.Errorf("%s() error = %v, want %v", name, err, algebra.ErrNotSquare)
+			}
+			if _, err := solve(a, b[1:], nil); err != algebra.ErrInvalidDimensions {
+				t.Errorf("%s() error = %v, want %v", name, err, algebra.ErrInvalidDimensions)
+			}
+		})
+	}
+}
+
+func TestCG_NotPositiveDefinite(t *testing.T) {
+	a := mustMatrix(t, [][]float64{
+		{1, 0},
+		{0, -1},
+	})
+	if _, err := CG(a, []float64{1, 1}, nil); err != algebra.ErrNotPositiveDefinite {
+		t.Errorf("CG() error = %v, want %v", err, algebra.ErrNotPositiveDefinite)
+	}
+}
+
+func TestIterativeSolvers_Singular(t *testing.T) {
+	tests := []struct {
+		name string
+		data [][]float64
+	}{
+		{name: "Test zero matrix", data: [][]float64{{0, 0, 0}, {0, 0, 0}, {0, 0, 0}}},
+		{name: "Test rank-deficient matrix", data: [][]float64{{1, 0}, {0, 0}}},
+	}
+	for _, tt := range tests {
+		a := mustMatrix(t, tt.data)
+		b := make([]float64, a.Rows())
+		for i := range b {
+			b[i] = 1
+		}
+
+		for name, solve := range map[string]func(algebra.Matrix, []float64, *IterativeSettings) (*IterativeResult, error){
+			"GMRES":    GMRES,
+			"BiCGSTAB": BiCGSTAB,
+		} {
+			t.Run(fmt.Sprintf("%s with %s", tt.name, name), func(t *testing.T) {
+				got, err := solve(a, b, nil)
+				if err != algebra.ErrNoConvergence {
+					t.Fatalf("%s() error = %v, want %v", name, err, algebra.ErrNoConvergence)
+				}
+				if got.Converged || math.IsNaN(got.Residual) || math.IsInf(got.Residual, 0) {
+					t.Errorf("%s() = %+v", name, got)
+				}
+				for i, x := range got.X {
+					if math.IsNaN(x) || math.IsInf(x, 0) {
+						t.Fatalf("%s() X[%d] = %v, want a finite iterate", name, i, x)
+					}
+				}
+			})
+		}
+	}
+}
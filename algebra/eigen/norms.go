@@ -0,0 +1,66 @@
+package eigen
+
+import "github.com/guilycst/numspace/algebra"
+
+// NormKind selects the matrix norm computed by Norm.
+type NormKind int
+
+const (
+	// Frobenius is the square root of the sum of the squared elements.
+	Frobenius NormKind = iota
+
+	// Norm1 is the largest absolute column sum, the norm induced by the vector L1 norm.
+	Norm1
+
+	// NormInf is the largest absolute row sum, the norm induced by the vector L∞ norm.
+	NormInf
+
+	// Spectral is the largest singular value, the norm induced by the vector L2 norm.
+	Spectral
+
+	// Nuclear is the sum of the singular values.
+	Nuclear
+)
+
+// Norm returns the norm of m selected by kind. The norm of an empty matrix is 0.
+// Spectral and Nuclear compute the singular values of m. Returns algebra.ErrInvalidNorm for
+// an unknown kind.
+func Norm(m algebra.Matrix, kind NormKind) (float64, error) {
+	if m == nil {
+		return 0, algebra.ErrNilMatrix
+	}
+
+	switch kind {
+	case Frobenius:
+		return algebra.Vector(dense(m)).Norm(), nil
+	case Norm1, NormInf:
+		abs, err := algebra.Abs(m)
+		if err != nil {
+			return 0, err
+		}
+
+		axis := algebra.ByCol
+		if kind == NormInf {
+			axis = algebra.ByRow
+		}
+		sums, err := algebra.Sum(abs, axis)
+		if err != nil {
+			return 0, err
+		}
+		return sums.NormInf(), nil
+	case Spectral, Nuclear:
+		d, err := ThinSVD(m)
+		if err != nil {
+			return 0, err
+		}
+
+		if kind == Spectral {
+			if len(d.Values) == 0 {
+				return 0, nil
+			}
+			return d.Values[0], nil
+		}
+		return algebra.Vector(d.Values).Norm1(), nil
+	}
+	return 0, algebra.ErrInvalidNorm
+}
@@ -0,0 +1,55 @@
+package eigen
+
+import (
+	"math"
+	"testing"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+func TestNorm(t *testing.T) {
+	a := [][]float64{
+		{1, -2},
+		{3, 4},
+	}
+	diagonal, err := algebra.NewDiagonal([]float64{3, -5})
+	if err != nil {
+		t.Fatalf("NewDiagonal() error = %v", err)
+	}
+	empty, _ := algebra.NewMatrixZero(0, 3)
+
+	tests := []struct {
+		name    string
+		m       algebra.Matrix
+		kind    NormKind
+		want    float64
+		wantErr error
+	}{
+		{name: "Test Frobenius norm", m: mustMatrix(t, a), kind: Frobenius, want: math.Sqrt(30)},
+		{name: "Test 1-norm", m: mustMatrix(t, a), kind: Norm1, want: 6},
+		{name: "Test ∞-norm", m: mustMatrix(t, a), kind: NormInf, want: 7},
+		{name: "Test spectral norm", m: mustMatrix(t, a), kind: Spectral, want: math.Sqrt(15 + 5*math.Sqrt(5))},
+		{name: "Test nuclear norm", m: mustMatrix(t, a), kind: Nuclear, want: math.Sqrt(50)},
+		{name: "Test spectral norm of rectangular matrix", m: mustMatrix(t, [][]float64{{3, 0, 4}}), kind: Spectral, want: 5},
+		{name: "Test spectral norm of diagonal matrix", m: diagonal, kind: Spectral, want: 5},
+		{name: "Test nuclear norm of diagonal matrix", m: diagonal, kind: Nuclear, want: 8},
+		{name: "Test 1-norm of diagonal matrix", m: diagonal, kind: Norm1, want: 5},
+		{name: "Test Frobenius norm without overflow", m: mustMatrix(t, [][]float64{{3e200, 4e200}}), kind: Frobenius, want: 5e200},
+		{name: "Test Frobenius norm of empty matrix", m: empty, kind: Frobenius, want: 0},
+		{name: "Test 1-norm of empty matrix", m: empty, kind: Norm1, want: 0},
+		{name: "Test spectral norm of empty matrix", m: empty, kind: Spectral, want: 0},
+		{name: "Test unknown norm should return error", m: mustMatrix(t, a), kind: NormKind(-1), wantErr: algebra.ErrInvalidNorm},
+		{name: "Test nil matrix should return error", m: nil, kind: Frobenius, wantErr: algebra.ErrNilMatrix},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Norm(tt.m, tt.kind)
+			if err != tt.wantErr {
+				t.Fatalf("Norm() error = %v, want %v", err, tt.wantErr)
+			}
+			if math.Abs(got-tt.want) > tolerance*max(1, tt.want) {
+				t.Errorf("Norm() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package eigen
+
+import (
+	"math"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+// Inverse returns the inverse of a square matrix computed from its LU factorization.
+// It returns algebra.ErrSingularMatrix when m is singular.
+func Inverse(m algebra.Matrix) (algebra.Matrix, error) {
+	d, err := squareLU(m)
+	if err != nil {
+		return nil, err
+	}
+
+	n := m.Rows()
+	id := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		id[i*n+i] = 1
+	}
+	return d.Solve(newMatrix(id, n, n))
+}
+
+// Det returns the determinant of a square matrix computed from its LU factorization.
+// For large matrices prefer LogDet, as the determinant easily overflows or underflows.
+func Det(m algebra.Matrix) (float64, error) {
+	d, err := squareLU(m)
+	if err != nil {
+		return 0, err
+	}
+	return d.Det(), nil
+}
+
+// LogDet returns the natural logarithm of the absolute value of the determinant of a square
+// matrix together with its sign, so that det = sign * exp(logAbsDet). For singular matrices
+// the sign is 0 and logAbsDet is -Inf.
+func LogDet(m algebra.Matrix) (logAbsDet, sign float64, err error) {
+	d, err := squareLU(m)
+	if err != nil {
+		return 0, 0, err
+	}
+	logAbsDet, sign = d.LogDet()
+	return logAbsDet, sign, nil
+}
+
+// Det returns the determinant of the factorized square matrix.
+func (d *LUDecomposition) Det() float64 {
+	n := len(d.Pivot)
+	det := d.Sign
+	for i := 0; i < n; i++ {
+		det *= d.lu[i*n+i]
+	}
+	return det
+}
+
+// LogDet returns the logarithm of the absolute value of the determinant of the factorized
+// square matrix together with its sign.
+func (d *LUDecomposition) LogDet() (logAbsDet, sign float64) {
+	n := len(d.Pivot)
+	sign = d.Sign
+	for i := 0; i < n; i++ {
+		u := d.lu[i*n+i]
+		if u == 0 {
+			return math.Inf(-1), 0
+		}
+		if u < 0 {
+			sign = -sign
+		}
+		logAbsDet += math.Log(math.Abs(u))
+	}
+	return logAbsDet, sign
+}
+
+// Rank returns the numerical rank of m, the number of singular values greater than tol.
+// A non-positive tol selects the default max(rows, cols) * epsilon * largest singular value.
+func Rank(m algebra.Matrix, tol float64) (int, error) {
+	d, err := ThinSVD(m)
+	if err != nil {
+		return 0, err
+	}
+
+	if tol <= 0 && len(d.Values) > 0 {
+		tol = float64(max(m.Rows(), m.Cols())) * epsilon * d.Values[0]
+	}
+
+	rank := 0
+	for _, s := range d.Values {
+		if s > tol {
+			rank++
+		}
+	}
+	return rank, nil
+}
+
+// Pinv returns the Moore-Penrose pseudo-inverse of m computed from its SVD. Singular values
+// not larger than rcond times the largest singular value are treated as zero. A non-positive
+// rcond selects the default max(rows, cols) * epsilon.
+func Pinv(m algebra.Matrix, rcond float64) (algebra.Matrix, error) {
+	if m == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	rows := m.Rows()
+	id := make([]float64, rows*rows)
+	for i := 0; i < rows; i++ {
+		id[i*rows+i] = 1
+	}
+
+	x, _, err := svdSolve(m, id, rows, rcond)
+	if err != nil {
+		return nil, err
+	}
+	return newMatrix(x, m.Cols(), rows), nil
+}
+
+// squareLU factorizes m after checking that it is a non-nil square matrix.
+func squareLU(m algebra.Matrix) (*LUDecomposition, error) {
+	if m == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	if m.Rows() != m.Cols() {
+		return nil, algebra.ErrNotSquare
+	}
+
+	return LU(m)
+}
@@ -0,0 +1,323 @@
+package eigen
+
+import (
+	"math"
+	"testing"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+func TestInverse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    [][]float64
+		want    [][]float64
+		wantErr error
+	}{
+		{
+			name: "Test inverse of 2x2 matrix",
+			data: [][]float64{
+				{4, 7},
+				{2, 6},
+			},
+			want: [][]float64{
+				{0.6, -0.7},
+				{-0.2, 0.4},
+			},
+		},
+		{
+			name: "Test inverse of 3x3 matrix",
+			data: [][]float64{
+				{1, 2, 3},
+				{0, 1, 4},
+				{5, 6, 0},
+			},
+			want: [][]float64{
+				{-24, 18, 5},
+				{20, -15, -4},
+				{-5, 4, 1},
+			},
+		},
+		{
+			name: "Test inverse of singular matrix should return error",
+			data: [][]float64{
+				{1, 2},
+				{2, 4},
+			},
+			wantErr: algebra.ErrSingularMatrix,
+		},
+		{
+			name: "Test inverse of rectangular matrix should return error",
+			data: [][]float64{
+				{1, 2, 3},
+			},
+			wantErr: algebra.ErrNotSquare,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustMatrix(t, tt.data)
+			got, err := Inverse(a)
+			if err != tt.wantErr {
+				t.Fatalf("Inverse() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !approxEqual(got, mustMatrix(t, tt.want), tolerance) {
+				t.Errorf("Inverse() = %v, want %v", got, tt.want)
+			}
+			if !approxEqual(mustMul(t, a, got), identity(a.Rows()), tolerance) {
+				t.Errorf("Inverse() A*A⁻¹ != I")
+			}
+		})
+	}
+}
+
+func TestDet(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          [][]float64
+		want          float64
+		wantLogAbsDet float64
+		wantSign      float64
+		wantErr       error
+	}{
+		{
+			name: "Test determinant of 2x2 matrix",
+			data: [][]float64{
+				{4, 7},
+				{2, 6},
+			},
+			want:          10,
+			wantLogAbsDet: math.Log(10),
+			wantSign:      1,
+		},
+		{
+			name: "Test determinant of 3x3 matrix with negative determinant",
+			data: [][]float64{
+				{1, 3, 2},
+				{2, 0, 1},
+				{1, 1, 2},
+			},
+			want:          -6,
+			wantLogAbsDet: math.Log(6),
+			wantSign:      -1,
+		},
+		{
+			name: "Test determinant of permutation matrix",
+			data: [][]float64{
+				{0, 1},
+				{1, 0},
+			},
+			want:          -1,
+			wantLogAbsDet: 0,
+			wantSign:      -1,
+		},
+		{
+			name: "Test determinant of singular matrix",
+			data: [][]float64{
+				{1, 2},
+				{2, 4},
+			},
+			want:          0,
+			wantLogAbsDet: math.Inf(-1),
+			wantSign:      0,
+		},
+		{
+			name: "Test determinant of rectangular matrix should return error",
+			data: [][]float64{
+				{1, 2, 3},
+			},
+			wantErr: algebra.ErrNotSquare,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustMatrix(t, tt.data)
+			got, err := Det(a)
+			if err != tt.wantErr {
+				t.Fatalf("Det() error = %v, want %v", err, tt.wantErr)
+			}
+			logAbsDet, sign, err := LogDet(a)
+			if err != tt.wantErr {
+				t.Fatalf("LogDet() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if math.Abs(got-tt.want) > tolerance {
+				t.Errorf("Det() = %v, want %v", got, tt.want)
+			}
+			if sign != tt.wantSign {
+				t.Errorf("LogDet() sign = %v, want %v", sign, tt.wantSign)
+			}
+			if logAbsDet != tt.wantLogAbsDet && math.Abs(logAbsDet-tt.wantLogAbsDet) > tolerance {
+				t.Errorf("LogDet() logAbsDet = %v, want %v", logAbsDet, tt.wantLogAbsDet)
+			}
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		name string
+		data [][]float64
+		tol  float64
+		want int
+	}{
+		{
+			name: "Test rank of full rank matrix",
+			data: [][]float64{
+				{1, 2},
+				{3, 4},
+			},
+			want: 2,
+		},
+		{
+			name: "Test rank of rank deficient matrix",
+			data: [][]float64{
+				{1, 2, 3},
+				{4, 5, 6},
+				{7, 8, 9},
+			},
+			want: 2,
+		},
+		{
+			name: "Test rank of wide matrix",
+			data: [][]float64{
+				{1, 2, 3, 4},
+				{2, 4, 6, 8},
+			},
+			want: 1,
+		},
+		{
+			name: "Test rank of zero matrix",
+			data: [][]float64{
+				{0, 0},
+				{0, 0},
+			},
+			want: 0,
+		},
+		{
+			name: "Test rank with custom tolerance ignores small singular values",
+			data: [][]float64{
+				{1, 0},
+				{0, 1e-6},
+			},
+			tol:  1e-3,
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Rank(mustMatrix(t, tt.data), tt.tol)
+			if err != nil {
+				t.Fatalf("Rank() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Rank() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPinv(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  [][]float64
+		rcond float64
+		want  [][]float64
+	}{
+		{
+			name: "Test pseudo-inverse of invertible matrix equals its inverse",
+			data: [][]float64{
+				{4, 7},
+				{2, 6},
+			},
+			want: [][]float64{
+				{0.6, -0.7},
+				{-0.2, 0.4},
+			},
+		},
+		{
+			name: "Test pseudo-inverse of tall matrix",
+			data: [][]float64{
+				{1, 0},
+				{0, 1},
+				{0, 0},
+			},
+			want: [][]float64{
+				{1, 0, 0},
+				{0, 1, 0},
+			},
+		},
+		{
+			name: "Test pseudo-inverse of rank deficient matrix",
+			data: [][]float64{
+				{1, 1},
+				{1, 1},
+			},
+			want: [][]float64{
+				{0.25, 0.25},
+				{0.25, 0.25},
+			},
+		},
+		{
+			name: "Test pseudo-inverse of row vector",
+			data: [][]float64{
+				{1, 2, 2},
+			},
+			want: [][]float64{
+				{1.0 / 9},
+				{2.0 / 9},
+				{2.0 / 9},
+			},
+		},
+		{
+			name: "Test pseudo-inverse with rcond cuts off small singular values",
+			data: [][]float64{
+				{2, 0},
+				{0, 1e-8},
+			},
+			rcond: 1e-6,
+			want: [][]float64{
+				{0.5, 0},
+				{0, 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustMatrix(t, tt.data)
+			got, err := Pinv(a, tt.rcond)
+			if err != nil {
+				t.Fatalf("Pinv() error = %v", err)
+			}
+			if !approxEqual(got, mustMatrix(t, tt.want), tolerance) {
+				t.Errorf("Pinv() = %v, want %v", got, tt.want)
+			}
+			if tt.rcond == 0 && !approxEqual(mustMul(t, mustMul(t, a, got), a), a, tolerance) {
+				t.Errorf("Pinv() A*A⁺*A != A")
+			}
+		})
+	}
+}
+
+func TestOperations_Nil(t *testing.T) {
+	if _, err := Inverse(nil); err != algebra.ErrNilMatrix {
+		t.Errorf("Inverse() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+	if _, err := Det(nil); err != algebra.ErrNilMatrix {
+		t.Errorf("Det() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+	if _, _, err := LogDet(nil); err != algebra.ErrNilMatrix {
+		t.Errorf("LogDet() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+	if _, err := Rank(nil, 0); err != algebra.ErrNilMatrix {
+		t.Errorf("Rank() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+	if _, err := Pinv(nil, 0); err != algebra.ErrNilMatrix {
+		t.Errorf("Pinv() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+}
@@ -0,0 +1,137 @@
+package eigen
+
+import "github.com/guilycst/numspace/algebra"
+
+// Preconditioner approximates the inverse of a matrix M ≈ A to accelerate the iterative solvers.
+type Preconditioner interface {
+	// Apply stores M⁻¹*r into z. Both slices have the size of the system.
+	Apply(z, r []float64)
+}
+
+// jacobi is the diagonal preconditioner M = diag(A).
+type jacobi struct {
+	inverse []float64
+}
+
+// JacobiPreconditioner returns the Jacobi preconditioner of the square matrix a, which scales
+// every equation by the inverse of its diagonal element. Returns algebra.ErrSingularMatrix if
+// a has a zero on its diagonal.
+func JacobiPreconditioner(a algebra.Matrix) (Preconditioner, error) {
+	if a == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	if a.Rows() != a.Cols() {
+		return nil, algebra.ErrNotSquare
+	}
+
+	inverse := make([]float64, a.Rows())
+	for i := range inverse {
+		d := a.MustAt(i, i)
+		if d == 0 {
+			return nil, algebra.ErrSingularMatrix
+		}
+		inverse[i] = 1 / d
+	}
+	return &jacobi{inverse: inverse}, nil
+}
+
+func (p *jacobi) Apply(z, r []float64) {
+	for i, d := range p.inverse {
+		z[i] = d * r[i]
+	}
+}
+
+// ilu0 holds the incomplete LU factors of a matrix, sharing the CSR layout of the matrix:
+// the strictly lower part of every row holds L (with an implicit unit diagonal) and the rest U.
+type ilu0 struct {
+	n       int
+	indptr  []int
+	indices []int
+	lu      []float64
+
+	// diag holds the position of the diagonal element of every row in lu.
+	diag []int
+}
+
+// ILUPreconditioner returns the ILU(0) preconditioner of the square matrix a: an incomplete
+// LU factorization that keeps the sparsity pattern of a and discards all fill-in. The matrix
+// is converted to CSR first, so sparse input is factorized without densifying it.
+// Returns algebra.ErrSingularMatrix if a pivot is zero or missing from the pattern.
+func ILUPreconditioner(a algebra.Matrix) (Preconditioner, error) {
+	if a == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	if a.Rows() != a.Cols() {
+		return nil, algebra.ErrNotSquare
+	}
+
+	csr, err := algebra.ToCSR(a)
+	if err != nil {
+		return nil, err
+	}
+
+	indptr, indices, data := csr.Raw()
+	n := a.Rows()
+	p := &ilu0{
+		n:       n,
+		indptr:  indptr,
+		indices: indices,
+		lu:      append([]float64(nil), data...),
+		diag:    make([]int, n),
+	}
+
+	// position maps a column to its index in lu for the row being factorized, or -1.
+	position := make([]int, n)
+	for j := range position {
+		position[j] = -1
+	}
+
+	for i := 0; i < n; i++ {
+		start, end := indptr[i], indptr[i+1]
+		for q := start; q < end; q++ {
+			position[indices[q]] = q
+		}
+
+		for q := start; q < end && indices[q] < i; q++ {
+			k := indices[q]
+			p.lu[q] /= p.lu[p.diag[k]]
+			for r := p.diag[k] + 1; r < indptr[k+1]; r++ {
+				if target := position[indices[r]]; target >= 0 {
+					p.lu[target] -= p.lu[q] * p.lu[r]
+				}
+			}
+		}
+
+		d := position[i]
+		for q := start; q < end; q++ {
+			position[indices[q]] = -1
+		}
+		if d < 0 || p.lu[d] == 0 {
+			return nil, algebra.ErrSingularMatrix
+		}
+		p.diag[i] = d
+	}
+	return p, nil
+}
+
+func (p *ilu0) Apply(z, r []float64) {
+	// Forward substitution with the unit lower-triangular L.
+	for i := 0; i < p.n; i++ {
+		sum := r[i]
+		for q := p.indptr[i]; q < p.diag[i]; q++ {
+			sum -= p.lu[q] * z[p.indices[q]]
+		}
+		z[i] = sum
+	}
+
+	// Back substitution with U.
+	for i := p.n - 1; i >= 0; i-- {
+		sum := z[i]
+		for q := p.diag[i] + 1; q < p.indptr[i+1]; q++ {
+			sum -= p.lu[q] * z[p.indices[q]]
+		}
+		z[i] = sum / p.lu[p.diag[i]]
+	}
+}
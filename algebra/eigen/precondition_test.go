@@ -0,0 +1,115 @@
+package eigen
+
+import (
+	"math"
+	"testing"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+func TestJacobiPreconditioner(t *testing.T) {
+	a := mustMatrix(t, [][]float64{
+		{2, 1, 0},
+		{1, 4, 1},
+		{0, 1, 8},
+	})
+	p, err := JacobiPreconditioner(a)
+	if err != nil {
+		t.Fatalf("JacobiPreconditioner() error = %v", err)
+	}
+
+	z := make([]float64, 3)
+	p.Apply(z, []float64{2, 2, 2})
+	for i, want := range []float64{1, 0.5, 0.25} {
+		if z[i] != want {
+			t.Errorf("Apply() = %v, want %v", z, []float64{1, 0.5, 0.25})
+			break
+		}
+	}
+}
+
+func TestILUPreconditioner(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  [][]float64
+		exact bool
+	}{
+		{
+			name: "Test ILU(0) of tridiagonal matrix is its exact LU",
+			data: [][]float64{
+				{4, -1, 0, 0},
+				{-1, 4, -1, 0},
+				{0, -1, 4, -1},
+				{0, 0, -1, 4},
+			},
+			exact: true,
+		},
+		{
+			name: "Test ILU(0) of non-symmetric matrix without fill-in is its exact LU",
+			data: [][]float64{
+				{3, 1, 0},
+				{2, 5, 1},
+				{0, 4, 6},
+			},
+			exact: true,
+		},
+		{
+			name: "Test ILU(0) drops fill-in",
+			data: [][]float64{
+				{4, 1, 1},
+				{1, 4, 0},
+				{1, 0, 4},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustMatrix(t, tt.data)
+			p, err := ILUPreconditioner(a)
+			if err != nil {
+				t.Fatalf("ILUPreconditioner() error = %v", err)
+			}
+
+			n := a.Rows()
+			want := make([]float64, n)
+			for i := range want {
+				want[i] = float64(i + 1)
+			}
+			b, err := algebra.MulVec(a, want)
+			if err != nil {
+				t.Fatalf("MulVec() error = %v", err)
+			}
+			z := make([]float64, n)
+			p.Apply(z, b)
+
+			var diff float64
+			for i := range want {
+				diff = max(diff, math.Abs(z[i]-want[i]))
+			}
+			if tt.exact && diff > tolerance {
+				t.Errorf("Apply() = %v, want %v", z, want)
+			}
+			if !tt.exact && (diff < tolerance || diff > 1) {
+				t.Errorf("Apply() = %v, want an approximation of %v", z, want)
+			}
+		})
+	}
+}
+
+func TestPreconditioner_Errors(t *testing.T) {
+	for name, newPreconditioner := range map[string]func(algebra.Matrix) (Preconditioner, error){
+		"JacobiPreconditioner": JacobiPreconditioner,
+		"ILUPreconditioner":    ILUPreconditioner,
+	} {
+		if _, err := newPreconditioner(nil); err != algebra.ErrNilMatrix {
+			t.Errorf("%s() error = %v, want %v", name, err, algebra.ErrNilMatrix)
+		}
+		if _, err := newPreconditioner(mustMatrix(t, [][]float64{{1, 2}})); err != algebra.ErrNotSquare {
+			t.Errorf("%s() error = %v, want %v", name, err, algebra.ErrNotSquare)
+		}
+		zeroDiagonal := mustMatrix(t, [][]float64{{1, 2}, {3, 0}})
+		if _, err := newPreconditioner(zeroDiagonal); err != algebra.ErrSingularMatrix {
+			t.Errorf("%s() error = %v, want %v", name, err, algebra.ErrSingularMatrix)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package eigen
+
+import (
+	"math"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+// Solve solves the linear system A*X = B for a square matrix A, where every column of B
+// is a separate right-hand side. It factorizes A with LU and returns algebra.ErrSingularMatrix
+// when A is singular.
+func Solve(a, b algebra.Matrix) (algebra.Matrix, error) {
+	if a == nil || b == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	if a.Rows() != a.Cols() {
+		return nil, algebra.ErrNotSquare
+	}
+
+	d, err := LU(a)
+	if err != nil {
+		return nil, err
+	}
+	return d.Solve(b)
+}
+
+// Solve solves A*X = B using the factorization of the square matrix A, so that many
+// systems sharing A can be solved for the cost of a single factorization.
+func (d *LUDecomposition) Solve(b algebra.Matrix) (algebra.Matrix, error) {
+	if b == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	n := len(d.Pivot)
+	if d.U.Rows() != d.U.Cols() || n != d.U.Cols() {
+		return nil, algebra.ErrNotSquare
+	}
+	if b.Rows() != n {
+		return nil, algebra.ErrInvalidDimensions
+	}
+	if d.IsSingular() {
+		return nil, algebra.ErrSingularMatrix
+	}
+
+	k := b.Cols()
+	x := make([]float64, n*k)
+	for i, p := range d.Pivot {
+		for j := 0; j < k; j++ {
+			x[i*k+j] = b.MustAt(p, j)
+		}
+	}
+
+	// Forward substitution with the unit lower-triangular L, then back substitution with U.
+	_, backend := algebra.CurrentBackend()
+	backend.Trsm(false, true, n, k, 1, d.lu, n, x, k)
+	backend.Trsm(true, false, n, k, 1, d.lu, n, x, k)
+
+	return newMatrix(x, n, k), nil
+}
+
+// IsSingular reports whether the factorized matrix is singular, that is whether U has a
+// diagonal element that is zero relative to the magnitude of the factors.
+func (d *LUDecomposition) IsSingular() bool {
+	rows, cols := d.U.Rows(), d.U.Cols()
+
+	var largest float64
+	for _, v := range d.lu {
+		largest = max(largest, math.Abs(v))
+	}
+	tol := largest * epsilon * float64(max(rows, cols))
+
+	for i := 0; i < rows; i++ {
+		if math.Abs(d.lu[i*cols+i]) <= tol {
+			return true
+		}
+	}
+	return false
+}
+
+// LeastSquaresSolution holds the result of a least-squares or minimum-norm solve.
+type LeastSquaresSolution struct {
+	// X is the solution with one column per right-hand side.
+	X algebra.Matrix
+
+	// Residuals holds the Euclidean norm of B - A*X for every column of B.
+	Residuals []float64
+
+	// Rank is the effective numerical rank of A.
+	Rank int
+}
+
+// LeastSquares finds the X minimizing the Euclidean norm of A*X - B for every column of B.
+// Overdetermined systems with full column rank are solved through a QR factorization of A.
+// Underdetermined and rank-deficient systems are solved through the SVD of A, in which case
+// the minimum-norm solution among all minimizers is returned.
+func LeastSquares(a, b algebra.Matrix) (*LeastSquaresSolution, error) {
+	if a == nil || b == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	rows, cols := a.Rows(), a.Cols()
+	if b.Rows() != rows {
+		return nil, algebra.ErrInvalidDimensions
+	}
+
+	ad, bd := dense(a), dense(b)
+	k := b.Cols()
+
+	x, rank, ok := qrSolve(ad, bd, rows, cols, k)
+	if !ok {
+		var err error
+		if x, rank, err = svdSolve(a, bd, k, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	residuals := make([]float64, k)
+	for j := 0; j < k; j++ {
+		var norm float64
+		for i := 0; i < rows; i++ {
+			r := bd[i*k+j]
+			for c := 0; c < cols; c++ {
+				r -= ad[i*cols+c] * x[c*k+j]
+			}
+			norm = math.Hypot(norm, r)
+		}
+		residuals[j] = norm
+	}
+
+	return &LeastSquaresSolution{
+		X:         newMatrix(x, cols, k),
+		Residuals: residuals,
+		Rank:      rank,
+	}, nil
+}
+
+// qrSolve solves the least-squares problem for the row-major rows x cols slice a and the
+// rows x k right-hand sides b through a Householder QR factorization. It reports false when
+// a is not tall or is numerically rank deficient, leaving the problem to svdSolve.
+// Neither input is modified.
+func qrSolve(a, b []float64, rows, cols, k int) ([]float64, int, bool) {
+	if rows < cols {
+		return nil, 0, false
+	}
+
+	r := append([]float64(nil), a...)
+	reflectors := householder(r, rows, cols)
+
+	var largest float64
+	for i := 0; i < cols; i++ {
+		largest = max(largest, math.Abs(r[i*cols+i]))
+	}
+	tol := largest * epsilon * float64(rows)
+	for i := 0; i < cols; i++ {
+		if math.Abs(r[i*cols+i]) <= tol {
+			return nil, 0, false
+		}
+	}
+
+	// Compute Qᵀ*B by applying the reflectors in order.
+	qtb := append([]float64(nil), b...)
+	for j, v := range reflectors {
+		applyReflector(qtb, rows, k, j, 0, v)
+	}
+
+	// Back substitution with the leading cols x cols block of R.
+	x := make([]float64, cols*k)
+	for i := cols - 1; i >= 0; i-- {
+		for j := 0; j < k; j++ {
+			s := qtb[i*k+j]
+			for c := i + 1; c < cols; c++ {
+				s -= r[i*cols+c] * x[c*k+j]
+			}
+			x[i*k+j] = s / r[i*cols+i]
+		}
+	}
+
+	return x, cols, true
+}
+
+// svdSolve computes the minimum-norm least-squares solution V*Σ⁺*Uᵀ*B for the matrix a and
+// the row-major rows x k right-hand sides b. Singular values not larger than rcond times
+// the largest one are treated as zero; a non-positive rcond selects max(rows, cols)*epsilon.
+// It also returns the number of singular values kept.
+func svdSolve(a algebra.Matrix, b []float64, k int, rcond float64) ([]float64, int, error) {
+	d, err := ThinSVD(a)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, cols := a.Rows(), a.Cols()
+	if rcond <= 0 {
+		rcond = float64(max(rows, cols)) * epsilon
+	}
+
+	rank := 0
+	for _, s := range d.Values {
+		if s > rcond*d.Values[0] {
+			rank++
+		}
+	}
+
+	// Project B onto the retained left singular vectors, scaled by the inverse singular values.
+	c := make([]float64, rank*k)
+	for r := 0; r < rank; r++ {
+		for j := 0; j < k; j++ {
+			var s float64
+			for i := 0; i < rows; i++ {
+				s += d.U.MustAt(i, r) * b[i*k+j]
+			}
+			c[r*k+j] = s / d.Values[r]
+		}
+	}
+
+	x := make([]float64, cols*k)
+	for i := 0; i < cols; i++ {
+		for j := 0; j < k; j++ {
+			var s float64
+			for r := 0; r < rank; r++ {
+				s += d.VT.MustAt(r, i) * c[r*k+j]
+			}
+			x[i*k+j] = s
+		}
+	}
+
+	return x, rank, nil
+}
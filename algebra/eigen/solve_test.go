@@ -0,0 +1,286 @@
+package eigen
+
+import (
+	"math"
+	"testing"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       [][]float64
+		b       [][]float64
+		want    [][]float64
+		wantErr error
+	}{
+		{
+			name: "Test solving 2x2 system",
+			a: [][]float64{
+				{2, 1},
+				{1, 3},
+			},
+			b: [][]float64{
+				{3},
+				{5},
+			},
+			want: [][]float64{
+				{0.8},
+				{1.4},
+			},
+		},
+		{
+			name: "Test solving 3x3 system that requires pivoting",
+			a: [][]float64{
+				{0, 2, 1},
+				{1, 1, 1},
+				{2, 1, 0},
+			},
+			b: [][]float64{
+				{7},
+				{6},
+				{4},
+			},
+			want: [][]float64{
+				{1},
+				{2},
+				{3},
+			},
+		},
+		{
+			name: "Test solving with multiple right-hand sides",
+			a: [][]float64{
+				{4, 3},
+				{6, 3},
+			},
+			b: [][]float64{
+				{1, 0, 10},
+				{0, 1, 12},
+			},
+			want: [][]float64{
+				{-0.5, 0.5, 1},
+				{1, -2.0 / 3, 2},
+			},
+		},
+		{
+			name: "Test solving singular system should return error",
+			a: [][]float64{
+				{1, 2, 3},
+				{4, 5, 6},
+				{7, 8, 9},
+			},
+			b: [][]float64{
+				{1},
+				{2},
+				{3},
+			},
+			wantErr: algebra.ErrSingularMatrix,
+		},
+		{
+			name: "Test solving with rectangular matrix should return error",
+			a: [][]float64{
+				{1, 2, 3},
+				{4, 5, 6},
+			},
+			b: [][]float64{
+				{1},
+				{2},
+			},
+			wantErr: algebra.ErrNotSquare,
+		},
+		{
+			name: "Test solving with mismatched right-hand side should return error",
+			a: [][]float64{
+				{1, 0},
+				{0, 1},
+			},
+			b: [][]float64{
+				{1},
+				{2},
+				{3},
+			},
+			wantErr: algebra.ErrInvalidDimensions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := mustMatrix(t, tt.a), mustMatrix(t, tt.b)
+			got, err := Solve(a, b)
+			if err != tt.wantErr {
+				t.Fatalf("Solve() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !approxEqual(got, mustMatrix(t, tt.want), tolerance) {
+				t.Errorf("Solve() = %v, want %v", got, tt.want)
+			}
+			if !approxEqual(mustMul(t, a, got), b, tolerance) {
+				t.Errorf("Solve() A*X != B")
+			}
+		})
+	}
+}
+
+func TestSolve_Nil(t *testing.T) {
+	a := mustMatrix(t, [][]float64{{1}})
+	if _, err := Solve(nil, a); err != algebra.ErrNilMatrix {
+		t.Errorf("Solve() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+	if _, err := Solve(a, nil); err != algebra.ErrNilMatrix {
+		t.Errorf("Solve() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+}
+
+func TestLUDecomposition_Solve(t *testing.T) {
+	a := mustMatrix(t, [][]float64{
+		{3, 1},
+		{1, 2},
+	})
+	d, err := LU(a)
+	if err != nil {
+		t.Fatalf("LU() error = %v", err)
+	}
+	for _, rhs := range [][][]float64{{{9}, {8}}, {{1}, {0}}, {{0}, {1}}} {
+		b := mustMatrix(t, rhs)
+		x, err := d.Solve(b)
+		if err != nil {
+			t.Fatalf("LUDecomposition.Solve() error = %v", err)
+		}
+		if !approxEqual(mustMul(t, a, x), b, tolerance) {
+			t.Errorf("LUDecomposition.Solve() A*X != B for B = %v", rhs)
+		}
+	}
+}
+
+func TestLeastSquares(t *testing.T) {
+	tests := []struct {
+		name          string
+		a             [][]float64
+		b             [][]float64
+		want          [][]float64
+		wantResiduals []float64
+		wantRank      int
+		wantErr       error
+	}{
+		{
+			name: "Test fitting a line through exact points",
+			a: [][]float64{
+				{1, 0},
+				{1, 1},
+				{1, 2},
+			},
+			b: [][]float64{
+				{1},
+				{3},
+				{5},
+			},
+			want: [][]float64{
+				{1},
+				{2},
+			},
+			wantResiduals: []float64{0},
+			wantRank:      2,
+		},
+		{
+			name: "Test fitting a line through noisy points",
+			a: [][]float64{
+				{1, 0},
+				{1, 1},
+				{1, 2},
+				{1, 3},
+			},
+			b: [][]float64{
+				{1},
+				{2},
+				{2},
+				{4},
+			},
+			want: [][]float64{
+				{0.9},
+				{0.9},
+			},
+			wantResiduals: []float64{math.Sqrt(0.7)},
+			wantRank:      2,
+		},
+		{
+			name: "Test minimum-norm solution of underdetermined system",
+			a: [][]float64{
+				{1, 1},
+			},
+			b: [][]float64{
+				{2},
+			},
+			want: [][]float64{
+				{1},
+				{1},
+			},
+			wantResiduals: []float64{0},
+			wantRank:      1,
+		},
+		{
+			name: "Test minimum-norm solution of rank deficient system",
+			a: [][]float64{
+				{1, 1},
+				{1, 1},
+				{1, 1},
+			},
+			b: [][]float64{
+				{1, 2},
+				{2, 2},
+				{3, 2},
+			},
+			want: [][]float64{
+				{1, 1},
+				{1, 1},
+			},
+			wantResiduals: []float64{math.Sqrt2, 0},
+			wantRank:      1,
+		},
+		{
+			name: "Test mismatched right-hand side should return error",
+			a: [][]float64{
+				{1, 0},
+				{0, 1},
+			},
+			b: [][]float64{
+				{1},
+			},
+			wantErr: algebra.ErrInvalidDimensions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LeastSquares(mustMatrix(t, tt.a), mustMatrix(t, tt.b))
+			if err != tt.wantErr {
+				t.Fatalf("LeastSquares() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !approxEqual(got.X, mustMatrix(t, tt.want), tolerance) {
+				t.Errorf("LeastSquares() X = %v, want %v", got.X, tt.want)
+			}
+			for i := range tt.wantResiduals {
+				if math.Abs(got.Residuals[i]-tt.wantResiduals[i]) > tolerance {
+					t.Errorf("LeastSquares() Residuals = %v, want %v", got.Residuals, tt.wantResiduals)
+					break
+				}
+			}
+			if got.Rank != tt.wantRank {
+				t.Errorf("LeastSquares() Rank = %v, want %v", got.Rank, tt.wantRank)
+			}
+		})
+	}
+}
+
+func TestLeastSquares_Nil(t *testing.T) {
+	a := mustMatrix(t, [][]float64{{1}})
+	if _, err := LeastSquares(nil, a); err != algebra.ErrNilMatrix {
+		t.Errorf("LeastSquares() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+	if _, err := LeastSquares(a, nil); err != algebra.ErrNilMatrix {
+		t.Errorf("LeastSquares() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+}
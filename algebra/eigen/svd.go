@@ -0,0 +1,210 @@
+package eigen
+
+import (
+	"math"
+	"sort"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+// maxJacobiSweeps bounds the number of sweeps of the one-sided Jacobi SVD.
+const maxJacobiSweeps = 100
+
+// SVDDecomposition holds the factors of an A = U*Σ*Vᵀ factorization.
+type SVDDecomposition struct {
+	// U holds the left singular vectors. It is rows x rows for a full factorization
+	// and rows x min(rows, cols) for a thin one.
+	U algebra.Matrix
+
+	// Sigma is the diagonal matrix of singular values. It is rows x cols for a full
+	// factorization and min(rows, cols) x min(rows, cols) for a thin one.
+	Sigma algebra.Matrix
+
+	// VT holds the right singular vectors as rows. It is cols x cols for a full
+	// factorization and min(rows, cols) x cols for a thin one.
+	VT algebra.Matrix
+
+	// Values are the min(rows, cols) singular values in descending order.
+	Values []float64
+}
+
+// SVD computes the full singular value decomposition of m using one-sided Jacobi rotations.
+func SVD(m algebra.Matrix) (*SVDDecomposition, error) {
+	return svd(m, true)
+}
+
+// ThinSVD computes the thin (economy) singular value decomposition of m, keeping only
+// the min(rows, cols) singular vectors on each side.
+func ThinSVD(m algebra.Matrix) (*SVDDecomposition, error) {
+	return svd(m, false)
+}
+
+func svd(m algebra.Matrix, full bool) (*SVDDecomposition, error) {
+	if m == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	rows, cols := m.Rows(), m.Cols()
+	k := min(rows, cols)
+	a := dense(m)
+
+	var u, vt []float64
+	var values []float64
+	var uCols, vtRows int
+	if rows >= cols {
+		var v []float64
+		var err error
+		u, values, v, err = jacobiSVD(a, rows, cols, full)
+		if err != nil {
+			return nil, err
+		}
+		uCols, vtRows = k, cols
+		if full {
+			uCols = rows
+		}
+		vt = transpose(v, cols, cols)
+	} else {
+		var ut []float64
+		var err error
+		ut, values, u, err = jacobiSVD(transpose(a, rows, cols), cols, rows, full)
+		if err != nil {
+			return nil, err
+		}
+		uCols, vtRows = rows, k
+		if full {
+			vtRows = cols
+		}
+		vt = transpose(ut, cols, vtRows)
+	}
+
+	sRows, sCols := k, k
+	if full {
+		sRows, sCols = rows, cols
+	}
+	sigma := make([]float64, sRows*sCols)
+	for i, s := range values {
+		sigma[i*sCols+i] = s
+	}
+
+	return &SVDDecomposition{
+		U:      newMatrix(u, rows, uCols),
+		Sigma:  newMatrix(sigma, sRows, sCols),
+		VT:     newMatrix(vt, vtRows, cols),
+		Values: values,
+	}, nil
+}
+
+// jacobiSVD decomposes the row-major rows x cols slice a, with rows >= cols, using the
+// one-sided (Hestenes) Jacobi method. It returns U as a row-major slice with rows columns
+// when full is set and cols columns otherwise, the singular values in descending order
+// and V as a row-major cols x cols slice.
+func jacobiSVD(a []float64, rows, cols int, full bool) ([]float64, []float64, []float64, error) {
+	// Work on columns, which are the vectors being orthogonalized.
+	w := make([][]float64, cols)
+	v := make([][]float64, cols)
+	for j := 0; j < cols; j++ {
+		w[j] = make([]float64, rows)
+		for i := 0; i < rows; i++ {
+			w[j][i] = a[i*cols+j]
+		}
+		v[j] = make([]float64, cols)
+		v[j][j] = 1
+	}
+
+	// Columns whose squared norm falls below negligible are numerically zero and are left alone,
+	// otherwise rounding noise would keep rotating them forever.
+	var negligible float64
+	for _, x := range a {
+		negligible += x * x
+	}
+	negligible *= epsilon * epsilon
+
+	converged := false
+	for sweep := 0; sweep < maxJacobiSweeps && !converged; sweep++ {
+		converged = true
+		for p := 0; p < cols-1; p++ {
+			for q := p + 1; q < cols; q++ {
+				alpha, beta, gamma := dot(w[p], w[p]), dot(w[q], w[q]), dot(w[p], w[q])
+				if gamma == 0 || min(alpha, beta) <= negligible || math.Abs(gamma) <= epsilon*math.Sqrt(alpha*beta) {
+					continue
+				}
+				converged = false
+
+				zeta := (beta - alpha) / (2 * gamma)
+				t := math.Copysign(1, zeta) / (math.Abs(zeta) + math.Sqrt(1+zeta*zeta))
+				c := 1 / math.Sqrt(1+t*t)
+				s := c * t
+				rotate(w[p], w[q], c, s)
+				rotate(v[p], v[q], c, s)
+			}
+		}
+	}
+	if !converged {
+		return nil, nil, nil, algebra.ErrNoConvergence
+	}
+
+	values := make([]float64, cols)
+	order := make([]int, cols)
+	for j := 0; j < cols; j++ {
+		values[j] = math.Sqrt(dot(w[j], w[j]))
+		order[j] = j
+	}
+	sort.SliceStable(order, func(x, y int) bool {
+		return values[order[x]] > values[order[y]]
+	})
+
+	sorted := make([]float64, cols)
+	vOut := make([]float64, cols*cols)
+	for j, o := range order {
+		sorted[j] = values[o]
+		for i := 0; i < cols; i++ {
+			vOut[i*cols+j] = v[o][i]
+		}
+	}
+
+	// Left singular vectors are the normalized columns. Columns whose singular value is
+	// negligible carry no direction and are completed to an orthonormal basis instead.
+	var cutoff float64
+	if cols > 0 {
+		cutoff = sorted[0] * epsilon * float64(rows)
+	}
+	rank := 0
+	for rank < cols && sorted[rank] > cutoff {
+		rank++
+	}
+
+	uCols := cols
+	if full {
+		uCols = rows
+	}
+	u := make([]float64, rows*uCols)
+	basis := make([]float64, rows*rank)
+	for j := 0; j < rank; j++ {
+		o := order[j]
+		for i := 0; i < rows; i++ {
+			x := w[o][i] / sorted[j]
+			u[i*uCols+j] = x
+			basis[i*rank+j] = x
+		}
+	}
+
+	if rank < uCols {
+		complement := accumulateReflectors(householder(basis, rows, rank), rows, rows)
+		for j := rank; j < uCols; j++ {
+			for i := 0; i < rows; i++ {
+				u[i*uCols+j] = complement[i*rows+j]
+			}
+		}
+	}
+
+	return u, sorted, vOut, nil
+}
+
+// rotate replaces the vector pair (x, y) in place with (c*x - s*y, s*x + c*y).
+func rotate(x, y []float64, c, s float64) {
+	for i := range x {
+		xi, yi := x[i], y[i]
+		x[i] = c*xi - s*yi
+		y[i] = s*xi + c*yi
+	}
+}
@@ -0,0 +1,141 @@
+package eigen
+
+import (
+	"math"
+	"testing"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+func TestSVD(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       [][]float64
+		thin       bool
+		wantValues []float64
+	}{
+		{
+			name: "Test full SVD of 2x2 matrix",
+			data: [][]float64{
+				{3, 0},
+				{4, 5},
+			},
+			wantValues: []float64{3 * math.Sqrt(5), math.Sqrt(5)},
+		},
+		{
+			name: "Test full SVD of diagonal matrix sorts values",
+			data: [][]float64{
+				{1, 0, 0},
+				{0, 3, 0},
+				{0, 0, 2},
+			},
+			wantValues: []float64{3, 2, 1},
+		},
+		{
+			name: "Test full SVD of 3x2 tall matrix",
+			data: [][]float64{
+				{1, 2},
+				{3, 4},
+				{5, 6},
+			},
+			wantValues: []float64{9.525518091565107, 0.5143005806586441},
+		},
+		{
+			name: "Test thin SVD of 3x2 tall matrix",
+			data: [][]float64{
+				{1, 2},
+				{3, 4},
+				{5, 6},
+			},
+			thin:       true,
+			wantValues: []float64{9.525518091565107, 0.5143005806586441},
+		},
+		{
+			name: "Test full SVD of 2x3 wide matrix",
+			data: [][]float64{
+				{3, 2, 2},
+				{2, 3, -2},
+			},
+			wantValues: []float64{5, 3},
+		},
+		{
+			name: "Test thin SVD of 2x3 wide matrix",
+			data: [][]float64{
+				{3, 2, 2},
+				{2, 3, -2},
+			},
+			thin:       true,
+			wantValues: []float64{5, 3},
+		},
+		{
+			name: "Test full SVD of rank deficient matrix",
+			data: [][]float64{
+				{1, 2, 3},
+				{2, 4, 6},
+				{1, 1, 1},
+				{0, 0, 0},
+			},
+			wantValues: []float64{math.Sqrt((73 + math.Sqrt(5209)) / 2), math.Sqrt((73 - math.Sqrt(5209)) / 2), 0},
+		},
+		{
+			name: "Test thin SVD of zero matrix",
+			data: [][]float64{
+				{0, 0},
+				{0, 0},
+			},
+			thin:       true,
+			wantValues: []float64{0, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustMatrix(t, tt.data)
+			decompose := SVD
+			if tt.thin {
+				decompose = ThinSVD
+			}
+			got, err := decompose(a)
+			if err != nil {
+				t.Fatalf("SVD() error = %v", err)
+			}
+
+			rows, cols, k := a.Rows(), a.Cols(), min(a.Rows(), a.Cols())
+			uCols, vtRows := rows, cols
+			if tt.thin {
+				uCols, vtRows = k, k
+			}
+			if got.U.Rows() != rows || got.U.Cols() != uCols {
+				t.Errorf("SVD() U is %dx%d, want %dx%d", got.U.Rows(), got.U.Cols(), rows, uCols)
+			}
+			if got.VT.Rows() != vtRows || got.VT.Cols() != cols {
+				t.Errorf("SVD() VT is %dx%d, want %dx%d", got.VT.Rows(), got.VT.Cols(), vtRows, cols)
+			}
+			if len(got.Values) != len(tt.wantValues) {
+				t.Fatalf("SVD() Values = %v, want %v", got.Values, tt.wantValues)
+			}
+			for i := range tt.wantValues {
+				if math.Abs(got.Values[i]-tt.wantValues[i]) > tolerance {
+					t.Errorf("SVD() Values = %v, want %v", got.Values, tt.wantValues)
+				}
+			}
+			if !approxEqual(mustMul(t, got.U.Transpose(), got.U), identity(uCols), tolerance) {
+				t.Errorf("SVD() U = %v does not have orthonormal columns", got.U)
+			}
+			if !approxEqual(mustMul(t, got.VT, got.VT.Transpose()), identity(vtRows), tolerance) {
+				t.Errorf("SVD() VT = %v does not have orthonormal rows", got.VT)
+			}
+			if !approxEqual(mustMul(t, mustMul(t, got.U, got.Sigma), got.VT), a, tolerance) {
+				t.Errorf("SVD() U*Σ*Vᵀ != A")
+			}
+		})
+	}
+}
+
+func TestSVD_Nil(t *testing.T) {
+	if _, err := SVD(nil); err != algebra.ErrNilMatrix {
+		t.Errorf("SVD() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+	if _, err := ThinSVD(nil); err != algebra.ErrNilMatrix {
+		t.Errorf("ThinSVD() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+}
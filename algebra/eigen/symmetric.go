@@ -0,0 +1,258 @@
+package eigen
+
+import (
+	"math"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+// maxQLIterations bounds the number of implicit QL iterations spent on a single eigenvalue.
+const maxQLIterations = 100
+
+// SymmetricDecomposition holds the eigenvalues and eigenvectors of a real symmetric
+// matrix A, such that A = V*diag(Values)*Vᵀ.
+type SymmetricDecomposition struct {
+	// Values are the eigenvalues in ascending order.
+	Values []float64
+
+	// Vectors is the orthonormal matrix V whose column j is the eigenvector of Values[j].
+	Vectors algebra.Matrix
+}
+
+// Symmetric computes the eigenvalues and eigenvectors of a real symmetric matrix by reducing
+// it to tridiagonal form with Householder transformations and then applying the implicit
+// QL algorithm. It returns algebra.ErrNotSquare for rectangular input and
+// algebra.ErrNotSymmetric when m is not symmetric.
+func Symmetric(m algebra.Matrix) (*SymmetricDecomposition, error) {
+	if m == nil {
+		return nil, algebra.ErrNilMatrix
+	}
+
+	n := m.Rows()
+	if n != m.Cols() {
+		return nil, algebra.ErrNotSquare
+	}
+
+	a := dense(m)
+	if !isSymmetric(a, n) {
+		return nil, algebra.ErrNotSymmetric
+	}
+
+	v := make([][]float64, n)
+	for i := range v {
+		v[i] = a[i*n : (i+1)*n]
+	}
+	d := make([]float64, n)
+	e := make([]float64, n)
+
+	if n > 0 {
+		tridiagonalize(v, d, e)
+		if err := tridiagonalQL(v, d, e); err != nil {
+			return nil, err
+		}
+	}
+
+	return &SymmetricDecomposition{
+		Values:  d,
+		Vectors: newMatrix(a, n, n),
+	}, nil
+}
+
+// tridiagonalize reduces the symmetric matrix held in v to tridiagonal form using Householder
+// transformations (EISPACK tred2). On return d holds the diagonal, e[1:] the sub-diagonal and
+// v the accumulated orthogonal transformation.
+func tridiagonalize(v [][]float64, d, e []float64) {
+	n := len(d)
+	for j := 0; j < n; j++ {
+		d[j] = v[n-1][j]
+	}
+
+	for i := n - 1; i > 0; i-- {
+		var scale, h float64
+		for k := 0; k < i; k++ {
+			scale += math.Abs(d[k])
+		}
+
+		if scale == 0 {
+			e[i] = d[i-1]
+			for j := 0; j < i; j++ {
+				d[j] = v[i-1][j]
+				v[i][j] = 0
+				v[j][i] = 0
+			}
+		} else {
+			for k := 0; k < i; k++ {
+				d[k] /= scale
+				h += d[k] * d[k]
+			}
+			f := d[i-1]
+			g := math.Sqrt(h)
+			if f > 0 {
+				g = -g
+			}
+			e[i] = scale * g
+			h -= f * g
+			d[i-1] = f - g
+			for j := 0; j < i; j++ {
+				e[j] = 0
+			}
+
+			for j := 0; j < i; j++ {
+				f = d[j]
+				v[j][i] = f
+				g = e[j] + v[j][j]*f
+				for k := j + 1; k <= i-1; k++ {
+					g += v[k][j] * d[k]
+					e[k] += v[k][j] * f
+				}
+				e[j] = g
+			}
+
+			f = 0
+			for j := 0; j < i; j++ {
+				e[j] /= h
+				f += e[j] * d[j]
+			}
+			hh := f / (h + h)
+			for j := 0; j < i; j++ {
+				e[j] -= hh * d[j]
+			}
+			for j := 0; j < i; j++ {
+				f = d[j]
+				g = e[j]
+				for k := j; k <= i-1; k++ {
+					v[k][j] -= f*e[k] + g*d[k]
+				}
+				d[j] = v[i-1][j]
+				v[i][j] = 0
+			}
+		}
+		d[i] = h
+	}
+
+	// Accumulate the transformations.
+	for i := 0; i < n-1; i++ {
+		v[n-1][i] = v[i][i]
+		v[i][i] = 1
+		h := d[i+1]
+		if h != 0 {
+			for k := 0; k <= i; k++ {
+				d[k] = v[k][i+1] / h
+			}
+			for j := 0; j <= i; j++ {
+				var g float64
+				for k := 0; k <= i; k++ {
+					g += v[k][i+1] * v[k][j]
+				}
+				for k := 0; k <= i; k++ {
+					v[k][j] -= g * d[k]
+				}
+			}
+		}
+		for k := 0; k <= i; k++ {
+			v[k][i+1] = 0
+		}
+	}
+	for j := 0; j < n; j++ {
+		d[j] = v[n-1][j]
+		v[n-1][j] = 0
+	}
+	v[n-1][n-1] = 1
+	e[0] = 0
+}
+
+// tridiagonalQL diagonalizes the symmetric tridiagonal matrix given by d and e using the
+// implicit QL algorithm (EISPACK tql2), updating the transformation held in v. On return
+// d holds the eigenvalues in ascending order and the columns of v the matching eigenvectors.
+func tridiagonalQL(v [][]float64, d, e []float64) error {
+	n := len(d)
+	for i := 1; i < n; i++ {
+		e[i-1] = e[i]
+	}
+	e[n-1] = 0
+
+	var f, tst1 float64
+	for l := 0; l < n; l++ {
+		// Find a small sub-diagonal element.
+		tst1 = max(tst1, math.Abs(d[l])+math.Abs(e[l]))
+		m := l
+		for m < n-1 && math.Abs(e[m]) > epsilon*tst1 {
+			m++
+		}
+
+		// If m == l, d[l] is already an eigenvalue, otherwise iterate.
+		for iter := 0; m > l; iter++ {
+			if iter == maxQLIterations {
+				return algebra.ErrNoConvergence
+			}
+
+			// Compute the implicit shift.
+			g := d[l]
+			p := (d[l+1] - g) / (2 * e[l])
+			r := math.Hypot(p, 1)
+			if p < 0 {
+				r = -r
+			}
+			d[l] = e[l] / (p + r)
+			d[l+1] = e[l] * (p + r)
+			dl1 := d[l+1]
+			h := g - d[l]
+			for i := l + 2; i < n; i++ {
+				d[i] -= h
+			}
+			f += h
+
+			// Implicit QL transformation.
+			p = d[m]
+			c, c2, c3 := 1.0, 1.0, 1.0
+			el1 := e[l+1]
+			var s, s2 float64
+			for i := m - 1; i >= l; i-- {
+				c3 = c2
+				c2 = c
+				s2 = s
+				g = c * e[i]
+				h = c * p
+				r = math.Hypot(p, e[i])
+				e[i+1] = s * r
+				s = e[i] / r
+				c = p / r
+				p = c*d[i] - s*g
+				d[i+1] = h + s*(c*g+s*d[i])
+
+				for k := 0; k < n; k++ {
+					h = v[k][i+1]
+					v[k][i+1] = s*v[k][i] + c*h
+					v[k][i] = c*v[k][i] - s*h
+				}
+			}
+			p = -s * s2 * c3 * el1 * e[l] / dl1
+			e[l] = s * p
+			d[l] = c * p
+
+			if math.Abs(e[l]) <= epsilon*tst1 {
+				break
+			}
+		}
+		d[l] += f
+		e[l] = 0
+	}
+
+	// Sort eigenvalues and corresponding vectors.
+	for i := 0; i < n-1; i++ {
+		k := i
+		for j := i + 1; j < n; j++ {
+			if d[j] < d[k] {
+				k = j
+			}
+		}
+		if k != i {
+			d[k], d[i] = d[i], d[k]
+			for j := 0; j < n; j++ {
+				v[j][i], v[j][k] = v[j][k], v[j][i]
+			}
+		}
+	}
+
+	return nil
+}
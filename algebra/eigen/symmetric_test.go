@@ -0,0 +1,129 @@
+package eigen
+
+import (
+	"math"
+	"testing"
+
+	"github.com/guilycst/numspace/algebra"
+)
+
+func TestSymmetric(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       [][]float64
+		wantValues []float64
+		wantErr    error
+	}{
+		{
+			name: "Test eigen decomposition of 2x2 symmetric matrix",
+			data: [][]float64{
+				{2, 1},
+				{1, 2},
+			},
+			wantValues: []float64{1, 3},
+		},
+		{
+			name: "Test eigen decomposition of diagonal matrix sorts values",
+			data: [][]float64{
+				{5, 0, 0},
+				{0, -1, 0},
+				{0, 0, 2},
+			},
+			wantValues: []float64{-1, 2, 5},
+		},
+		{
+			name: "Test eigen decomposition of 3x3 symmetric matrix",
+			data: [][]float64{
+				{2, -1, 0},
+				{-1, 2, -1},
+				{0, -1, 2},
+			},
+			wantValues: []float64{2 - math.Sqrt2, 2, 2 + math.Sqrt2},
+		},
+		{
+			name: "Test eigen decomposition of 4x4 covariance matrix",
+			data: [][]float64{
+				{4, 1, 2, 0.5},
+				{1, 3, 0, 1},
+				{2, 0, 5, 1.5},
+				{0.5, 1, 1.5, 2},
+			},
+		},
+		{
+			name: "Test eigen decomposition of matrix with repeated eigenvalues",
+			data: [][]float64{
+				{1, 1, 1},
+				{1, 1, 1},
+				{1, 1, 1},
+			},
+			wantValues: []float64{0, 0, 3},
+		},
+		{
+			name: "Test eigen decomposition of 1x1 matrix",
+			data: [][]float64{
+				{7},
+			},
+			wantValues: []float64{7},
+		},
+		{
+			name: "Test eigen decomposition of non-symmetric matrix should return error",
+			data: [][]float64{
+				{1, 2},
+				{3, 4},
+			},
+			wantErr: algebra.ErrNotSymmetric,
+		},
+		{
+			name: "Test eigen decomposition of rectangular matrix should return error",
+			data: [][]float64{
+				{1, 2, 3},
+			},
+			wantErr: algebra.ErrNotSquare,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustMatrix(t, tt.data)
+			got, err := Symmetric(a)
+			if err != tt.wantErr {
+				t.Fatalf("Symmetric() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			n := a.Rows()
+			if tt.wantValues != nil {
+				for i := range tt.wantValues {
+					if math.Abs(got.Values[i]-tt.wantValues[i]) > tolerance {
+						t.Errorf("Symmetric() Values = %v, want %v", got.Values, tt.wantValues)
+						break
+					}
+				}
+			}
+			for i := 1; i < n; i++ {
+				if got.Values[i] < got.Values[i-1] {
+					t.Errorf("Symmetric() Values = %v are not sorted", got.Values)
+				}
+			}
+			if !approxEqual(mustMul(t, got.Vectors.Transpose(), got.Vectors), identity(n), tolerance) {
+				t.Errorf("Symmetric() Vectors = %v are not orthonormal", got.Vectors)
+			}
+
+			lambda := make([][]float64, n)
+			for i := range lambda {
+				lambda[i] = make([]float64, n)
+				lambda[i][i] = got.Values[i]
+			}
+			if !approxEqual(mustMul(t, a, got.Vectors), mustMul(t, got.Vectors, mustMatrix(t, lambda)), tolerance) {
+				t.Errorf("Symmetric() A*V != V*Λ")
+			}
+		})
+	}
+}
+
+func TestSymmetric_Nil(t *testing.T) {
+	if _, err := Symmetric(nil); err != algebra.ErrNilMatrix {
+		t.Errorf("Symmetric() error = %v, want %v", err, algebra.ErrNilMatrix)
+	}
+}
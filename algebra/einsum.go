@@ -0,0 +1,254 @@
+package algebra
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// einsumTerm is an operand of an einsum contraction together with the label of each of its
+// axes. After diagonal extraction every label appears at most once per term.
+type einsumTerm struct {
+	t      *Tensor
+	labels []rune
+}
+
+// Einsum evaluates the Einstein summation spec over the operands and returns the result as a
+// new tensor. The spec lists one label per axis for every operand, separated by commas, and
+// the labels of the result after "->":
+//
+//	Einsum("ij,jk->ik", a, b)     // matrix product
+//	Einsum("bij,bjk->bik", a, b)  // batched matrix product
+//	Einsum("ii->", a)             // trace
+//	Einsum("i,j->ij", x, y)       // outer product
+//	Einsum("ij->ji", a)           // transpose
+//
+// Labels missing from the result are summed over, and a label repeated within one operand
+// selects its diagonal. Without "->" the result holds the labels appearing exactly once, in
+// alphabetical order. Axes sharing a label must have the same length.
+//
+// Contractions of two operands that reduce to (batched) matrix products are evaluated with
+// FlatMatrix.Mul; anything else falls back to a loop over every combination of labels.
+func Einsum(spec string, operands ...*Tensor) (*Tensor, error) {
+	inputs, output, err := parseEinsum(spec, len(operands))
+	if err != nil {
+		return nil, err
+	}
+
+	sizes := map[rune]int{}
+	terms := make([]einsumTerm, len(operands))
+	for k, op := range operands {
+		if op == nil {
+			return nil, ErrNilMatrix
+		}
+
+		if len(inputs[k]) != op.NDim() {
+			return nil, ErrInvalidDimensions
+		}
+
+		for axis, label := range inputs[k] {
+			if size, ok := sizes[label]; ok && size != op.shape[axis] {
+				return nil, ErrInvalidDimensions
+			}
+			sizes[label] = op.shape[axis]
+		}
+		terms[k] = einsumDiagonal(op, inputs[k])
+	}
+
+	if len(terms) == 2 {
+		if result, ok := einsumMatMul(terms[0], terms[1], output, sizes); ok {
+			return result, nil
+		}
+	}
+	return einsumLoop(terms, output, sizes), nil
+}
+
+// parseEinsum splits spec into the labels of each of the n operands and of the result.
+func parseEinsum(spec string, n int) ([][]rune, []rune, error) {
+	spec = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, spec)
+
+	lhs, rhs, explicit := strings.Cut(spec, "->")
+	terms := strings.Split(lhs, ",")
+	if len(terms) != n || strings.Contains(rhs, "->") {
+		return nil, nil, ErrInvalidEinsum
+	}
+
+	inputs := make([][]rune, n)
+	counts := map[rune]int{}
+	for k, term := range terms {
+		for _, label := range term {
+			if !unicode.IsLetter(label) {
+				return nil, nil, ErrInvalidEinsum
+			}
+			inputs[k] = append(inputs[k], label)
+			counts[label]++
+		}
+	}
+
+	output := []rune{}
+	if !explicit {
+		for label, count := range counts {
+			if count == 1 {
+				output = append(output, label)
+			}
+		}
+		slices.Sort(output)
+		return inputs, output, nil
+	}
+
+	for _, label := range rhs {
+		if counts[label] == 0 || slices.Contains(output, label) {
+			return nil, nil, ErrInvalidEinsum
+		}
+		output = append(output, label)
+	}
+	return inputs, output, nil
+}
+
+// einsumDiagonal returns t as a term whose labels are unique, merging the axes of a repeated
+// label into a single axis that walks their diagonal.
+func einsumDiagonal(t *Tensor, labels []rune) einsumTerm {
+	view := &Tensor{data: t.data, offset: t.offset}
+	var unique []rune
+	for axis, label := range labels {
+		if i := slices.Index(unique, label); i >= 0 {
+			view.strides[i] += t.strides[axis]
+			continue
+		}
+		unique = append(unique, label)
+		view.shape = append(view.shape, t.shape[axis])
+		view.strides = append(view.strides, t.strides[axis])
+	}
+	return einsumTerm{t: view, labels: unique}
+}
+
+// einsumLoop evaluates the contraction by visiting every combination of labels, accumulating
+// the product of the operands into the element of the result the output labels select.
+func einsumLoop(terms []einsumTerm, output []rune, sizes map[rune]int) *Tensor {
+	labels := slices.Clone(output)
+	for _, term := range terms {
+		for _, label := range term.labels {
+			if !slices.Contains(labels, label) {
+				labels = append(labels, label)
+			}
+		}
+	}
+
+	shape := make([]int, len(labels))
+	for i, label := range labels {
+		shape[i] = sizes[label]
+	}
+	result, _ := NewTensorZero(shape[:len(output)]...)
+
+	// Every operand, and the result last, gets a stride for each label, zero where absent.
+	strides := make([][]int, len(terms)+1)
+	offsets := make([]int, len(terms)+1)
+	for k, term := range terms {
+		strides[k] = make([]int, len(labels))
+		for axis, label := range term.labels {
+			strides[k][slices.Index(labels, label)] = term.t.strides[axis]
+		}
+		offsets[k] = term.t.offset
+	}
+	strides[len(terms)] = make([]int, len(labels))
+	copy(strides[len(terms)], result.strides)
+
+	walk(shape, strides, offsets, func(p []int) {
+		product := 1.0
+		for k, term := range terms {
+			product *= term.t.data[p[k]]
+		}
+		result.data[p[len(terms)]] += product
+	})
+	return result
+}
+
+// einsumMatMul evaluates the contraction of a and b as a batch of matrix products when every
+// label is either a batch label shared by a, b and the result, a contracted label shared by a
+// and b only, or a free label of one operand kept in the result. It reports false otherwise.
+func einsumMatMul(a, b einsumTerm, output []rune, sizes map[rune]int) (*Tensor, bool) {
+	var batch, rows, inner, cols []rune
+	for _, label := range a.labels {
+		inB, inOut := slices.Contains(b.labels, label), slices.Contains(output, label)
+		switch {
+		case inB && inOut:
+			batch = append(batch, label)
+		case inB:
+			inner = append(inner, label)
+		case inOut:
+			rows = append(rows, label)
+		default:
+			return nil, false
+		}
+	}
+	for _, label := range b.labels {
+		inA, inOut := slices.Contains(a.labels, label), slices.Contains(output, label)
+		switch {
+		case inA:
+		case inOut:
+			cols = append(cols, label)
+		default:
+			return nil, false
+		}
+	}
+
+	// Without a contracted label or a free label on each side there is no matrix product to
+	// speak of, only element-wise products that the loop handles without the per-batch overhead.
+	if len(inner) == 0 && (len(rows) == 0 || len(cols) == 0) {
+		return nil, false
+	}
+
+	size := func(labels []rune) int {
+		n := 1
+		for _, label := range labels {
+			n *= sizes[label]
+		}
+		return n
+	}
+	nb, m, k, n := size(batch), size(rows), size(inner), size(cols)
+	if nb == 0 || m == 0 || k == 0 || n == 0 {
+		return nil, false
+	}
+
+	ad := einsumArrange(a, slices.Concat(batch, rows, inner))
+	bd := einsumArrange(b, slices.Concat(batch, inner, cols))
+	data := make([]float64, 0, nb*m*n)
+	for i := 0; i < nb; i++ {
+		am := &FlatMatrix{data: ad[i*m*k : (i+1)*m*k], rows: m, cols: k}
+		bm := &FlatMatrix{data: bd[i*k*n : (i+1)*k*n], rows: k, cols: n}
+		product, _ := am.Mul(bm)
+		data = append(data, product.(*FlatMatrix).data...)
+	}
+
+	labels := slices.Concat(batch, rows, cols)
+	shape := make([]int, len(labels))
+	for i, label := range labels {
+		shape[i] = sizes[label]
+	}
+	result, _ := NewTensor(data, shape...)
+
+	axes := make([]int, len(output))
+	for i, label := range output {
+		axes[i] = slices.Index(labels, label)
+	}
+	result, _ = result.Transpose(axes...)
+	return result.compact(), true
+}
+
+// einsumArrange returns the elements of term in row-major order after permuting its axes to
+// follow labels.
+func einsumArrange(term einsumTerm, labels []rune) []float64 {
+	axes := make([]int, len(labels))
+	for i, label := range labels {
+		axes[i] = slices.Index(term.labels, label)
+	}
+
+	t, _ := term.t.Transpose(axes...)
+	t = t.compact()
+	return t.data[t.offset : t.offset+t.Size()]
+}
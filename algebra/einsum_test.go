@@ -0,0 +1,125 @@
+package algebra
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestEinsum(t *testing.T) {
+	a := arange(t, 2, 3)
+	b := arange(t, 3, 2)
+	x := mustTensor(t, []float64{1, 2}, 2)
+	y := mustTensor(t, []float64{1, 10, 100}, 3)
+	square := arange(t, 3, 3)
+
+	tests := []struct {
+		name     string
+		spec     string
+		operands []*Tensor
+		shape    []int
+		want     []float64
+		wantErr  error
+	}{
+		{name: "Test matrix product", spec: "ij,jk->ik", operands: []*Tensor{a, b}, shape: []int{2, 2}, want: []float64{10, 13, 28, 40}},
+		{name: "Test implicit matrix product", spec: "ij,jk", operands: []*Tensor{a, b}, shape: []int{2, 2}, want: []float64{10, 13, 28, 40}},
+		{name: "Test transposed matrix product", spec: "ij,jk->ki", operands: []*Tensor{a, b}, shape: []int{2, 2}, want: []float64{10, 28, 13, 40}},
+		{name: "Test product with transposed operand", spec: "ij,kj->ik", operands: []*Tensor{a, a}, shape: []int{2, 2}, want: []float64{5, 14, 14, 50}},
+		{name: "Test matrix-vector product", spec: "ij,j->i", operands: []*Tensor{a, y}, shape: []int{2}, want: []float64{210, 543}},
+		{name: "Test inner product", spec: "i,i->", operands: []*Tensor{y, y}, shape: []int{}, want: []float64{10101}},
+		{name: "Test outer product", spec: "i,j->ij", operands: []*Tensor{x, y}, shape: []int{2, 3}, want: []float64{1, 10, 100, 2, 20, 200}},
+		{name: "Test Hadamard product", spec: "ij,ij->ij", operands: []*Tensor{a, a}, shape: []int{2, 3}, want: []float64{0, 1, 4, 9, 16, 25}},
+		{name: "Test trace", spec: "ii->", operands: []*Tensor{square}, shape: []int{}, want: []float64{12}},
+		{name: "Test implicit trace", spec: "ii", operands: []*Tensor{square}, shape: []int{}, want: []float64{12}},
+		{name: "Test diagonal", spec: "ii->i", operands: []*Tensor{square}, shape: []int{3}, want: []float64{0, 4, 8}},
+		{name: "Test transpose", spec: "ij->ji", operands: []*Tensor{a}, shape: []int{3, 2}, want: []float64{0, 3, 1, 4, 2, 5}},
+		{name: "Test implicit transpose", spec: "ji", operands: []*Tensor{a}, shape: []int{3, 2}, want: []float64{0, 3, 1, 4, 2, 5}},
+		{name: "Test column sums", spec: "ij->j", operands: []*Tensor{a}, shape: []int{3}, want: []float64{3, 5, 7}},
+		{name: "Test sum of all elements", spec: "i j -> ", operands: []*Tensor{a}, shape: []int{}, want: []float64{15}},
+		{name: "Test bilinear form", spec: "i,ij,j->", operands: []*Tensor{x, a, y}, shape: []int{}, want: []float64{1296}},
+		{name: "Test operand count mismatch should return error", spec: "ij,jk->ik", operands: []*Tensor{a}, wantErr: ErrInvalidEinsum},
+		{name: "Test invalid label should return error", spec: "i1->i", operands: []*Tensor{a}, wantErr: ErrInvalidEinsum},
+		{name: "Test unknown output label should return error", spec: "ij->k", operands: []*Tensor{a}, wantErr: ErrInvalidEinsum},
+		{name: "Test repeated output label should return error", spec: "ij->ii", operands: []*Tensor{a}, wantErr: ErrInvalidEinsum},
+		{name: "Test double arrow should return error", spec: "ij->ij->ij", operands: []*Tensor{a}, wantErr: ErrInvalidEinsum},
+		{name: "Test rank mismatch should return error", spec: "ijk->i", operands: []*Tensor{a}, wantErr: ErrInvalidDimensions},
+		{name: "Test size mismatch should return error", spec: "ij,jk->ik", operands: []*Tensor{a, a}, wantErr: ErrInvalidDimensions},
+		{name: "Test nil operand should return error", spec: "ij,jk->ik", operands: []*Tensor{a, nil}, wantErr: ErrNilMatrix},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Einsum(tt.spec, tt.operands...)
+			if err != tt.wantErr {
+				t.Fatalf("Einsum() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil {
+				assertTensor(t, "Einsum()", got, tt.shape, tt.want)
+			}
+		})
+	}
+}
+
+func TestEinsum_MatMulPath(t *testing.T) {
+	if err := RegisterBackend("counting", counting); err != nil && err != ErrBackendExists {
+		t.Fatalf("RegisterBackend() error = %v", err)
+	}
+	if err := SetBackend("counting"); err != nil {
+		t.Fatalf("SetBackend() error = %v", err)
+	}
+	defer SetBackend(DefaultBackend)
+
+	r := rand.New(rand.NewSource(1))
+	random := func(shape ...int) *Tensor {
+		size, _ := shapeSize(shape)
+		return mustTensor(t, randomSlice(r, size), shape...)
+	}
+
+	tests := []struct {
+		name      string
+		spec      string
+		operands  []*Tensor
+		wantGemms int
+	}{
+		{name: "Test batched matrix product", spec: "bij,bjk->bik", operands: []*Tensor{random(4, 3, 5), random(4, 5, 2)}, wantGemms: 4},
+		{name: "Test attention scores", spec: "bhqd,bhkd->bhqk", operands: []*Tensor{random(2, 3, 4, 8), random(2, 3, 6, 8)}, wantGemms: 6},
+		{name: "Test batch label in the middle", spec: "ibj,jbk->bki", operands: []*Tensor{random(3, 2, 4), random(4, 2, 5)}, wantGemms: 2},
+		{name: "Test contraction over two labels", spec: "ijk,jkl->il", operands: []*Tensor{random(2, 3, 4), random(3, 4, 5)}, wantGemms: 1},
+		{name: "Test outer product of matrices", spec: "ij,kl->ikjl", operands: []*Tensor{random(2, 3), random(4, 5)}, wantGemms: 1},
+		{name: "Test transposed view operand", spec: "ij,jk->ik", operands: []*Tensor{mustTranspose(t, random(4, 3)), random(4, 2)}, wantGemms: 1},
+		{name: "Test summed free label falls back to the loop", spec: "ij,jk->k", operands: []*Tensor{random(2, 3), random(3, 4)}},
+		{name: "Test batched inner product", spec: "ij,ij->i", operands: []*Tensor{random(2, 3), random(2, 3)}, wantGemms: 2},
+		{name: "Test element-wise product falls back to the loop", spec: "ij,ij->ji", operands: []*Tensor{random(2, 3), random(2, 3)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clear(counting.calls)
+			got, err := Einsum(tt.spec, tt.operands...)
+			if err != nil {
+				t.Fatalf("Einsum() error = %v", err)
+			}
+			if counting.calls["Gemm"] != tt.wantGemms {
+				t.Errorf("Einsum() made %d Gemm calls, want %d", counting.calls["Gemm"], tt.wantGemms)
+			}
+
+			inputs, output, _ := parseEinsum(tt.spec, len(tt.operands))
+			terms := make([]einsumTerm, len(tt.operands))
+			sizes := map[rune]int{}
+			for k, op := range tt.operands {
+				for axis, label := range inputs[k] {
+					sizes[label] = op.shape[axis]
+				}
+				terms[k] = einsumDiagonal(op, inputs[k])
+			}
+			want := einsumLoop(terms, output, sizes)
+			if !reflect.DeepEqual(got.Shape(), want.Shape()) {
+				t.Fatalf("Einsum() shape = %v, want %v", got.Shape(), want.Shape())
+			}
+			for i, v := range want.Data() {
+				if math.Abs(got.Data()[i]-v) > 1e-12 {
+					t.Fatalf("Einsum() = %v, want %v", got.Data(), want.Data())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package algebra
+
+import "math"
+
+// Apply returns the matrix whose element (i, j) is f(i, j, m[i][j]), as a new FlatMatrix.
+func Apply(m Matrix, f func(i, j int, v float64) float64) (Matrix, error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+
+	rows, cols := m.Rows(), m.Cols()
+	at := elementAt(m)
+	result := &FlatMatrix{data: make([]float64, rows*cols), rows: rows, cols: cols}
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			result.data[i*cols+j] = f(i, j, at(i, j))
+		}
+	}
+	return result, nil
+}
+
+// Map returns the matrix whose elements are f applied to the elements of m.
+func Map(m Matrix, f func(v float64) float64) (Matrix, error) {
+	return Apply(m, func(_, _ int, v float64) float64 { return f(v) })
+}
+
+// Zip returns the matrix whose element (i, j) is f(a[i][j], b[i][j]).
+// Returns ErrInvalidDimensions unless a and b have the same dimensions.
+func Zip(a, b Matrix, f func(x, y float64) float64) (Matrix, error) {
+	if a == nil || b == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if !a.CompareDimensions(b) {
+		return nil, ErrInvalidDimensions
+	}
+
+	at := elementAt(b)
+	return Apply(a, func(i, j int, v float64) float64 { return f(v, at(i, j)) })
+}
+
+// Hadamard returns the element-wise product of a and b.
+func Hadamard(a, b Matrix) (Matrix, error) {
+	return Zip(a, b, func(x, y float64) float64 { return x * y })
+}
+
+// Div returns the element-wise quotient of a and b. Division by zero follows IEEE 754,
+// yielding ±Inf or NaN.
+func Div(a, b Matrix) (Matrix, error) {
+	return Zip(a, b, func(x, y float64) float64 { return x / y })
+}
+
+// Pow returns the matrix whose elements are those of m raised to the power p.
+// This is not the matrix power m^p.
+func Pow(m Matrix, p float64) (Matrix, error) {
+	return Map(m, func(v float64) float64 { return math.Pow(v, p) })
+}
+
+// Abs returns the matrix of the absolute values of the elements of m.
+func Abs(m Matrix) (Matrix, error) {
+	return Map(m, math.Abs)
+}
+
+// Exp returns the matrix whose elements are e raised to the elements of m.
+// This is not the matrix exponential.
+func Exp(m Matrix) (Matrix, error) {
+	return Map(m, math.Exp)
+}
+
+// Log returns the matrix of the natural logarithms of the elements of m.
+// Non-positive elements yield -Inf or NaN, as in math.Log.
+func Log(m Matrix) (Matrix, error) {
+	return Map(m, math.Log)
+}
+
+// Clamp returns the matrix whose elements are those of m limited to the range [lo, hi].
+// Returns ErrInvalidBounds if lo > hi.
+func Clamp(m Matrix, lo, hi float64) (Matrix, error) {
+	if lo > hi {
+		return nil, ErrInvalidBounds
+	}
+	return Map(m, func(v float64) float64 { return min(max(v, lo), hi) })
+}
+
+// elementAt returns a function reading element (i, j) of m, indexing the storage directly when
+// m is backed by contiguous rows.
+func elementAt(m Matrix) func(i, j int) float64 {
+	if data, stride, ok := strided(m); ok {
+		return func(i, j int) float64 { return data[i*stride+j] }
+	}
+	return m.MustAt
+}
@@ -0,0 +1,94 @@
+package algebra
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestElementwise(t *testing.T) {
+	a := &FlatMatrix{data: []float64{1, -2, 3, -4}, rows: 2, cols: 2}
+	b := &FlatMatrix{data: []float64{2, 4, -1, 8}, rows: 2, cols: 2}
+	flat := func(data ...float64) Matrix {
+		return &FlatMatrix{data: data, rows: 2, cols: 2}
+	}
+
+	tests := []struct {
+		name    string
+		op      func() (Matrix, error)
+		want    Matrix
+		wantErr error
+	}{
+		{
+			name: "Test Apply with indices",
+			op: func() (Matrix, error) {
+				return Apply(a, func(i, j int, v float64) float64 { return v + float64(10*i+j) })
+			},
+			want: flat(1, -1, 13, 7),
+		},
+		{name: "Test Map", op: func() (Matrix, error) { return Map(a, func(v float64) float64 { return 2 * v }) }, want: flat(2, -4, 6, -8)},
+		{name: "Test Zip", op: func() (Matrix, error) { return Zip(a, b, math.Max) }, want: flat(2, 4, 3, 8)},
+		{name: "Test Hadamard", op: func() (Matrix, error) { return Hadamard(a, b) }, want: flat(2, -8, -3, -32)},
+		{name: "Test Div", op: func() (Matrix, error) { return Div(a, b) }, want: flat(0.5, -0.5, -3, -0.5)},
+		{name: "Test Pow", op: func() (Matrix, error) { return Pow(a, 2) }, want: flat(1, 4, 9, 16)},
+		{name: "Test Abs", op: func() (Matrix, error) { return Abs(a) }, want: flat(1, 2, 3, 4)},
+		{name: "Test Exp", op: func() (Matrix, error) { return Exp(flat(0, 1, 0, 1)) }, want: flat(1, math.E, 1, math.E)},
+		{name: "Test Log", op: func() (Matrix, error) { return Log(flat(1, math.E, 1, math.E)) }, want: flat(0, 1, 0, 1)},
+		{name: "Test Clamp", op: func() (Matrix, error) { return Clamp(a, -1, 2) }, want: flat(1, -1, 2, -1)},
+		{name: "Test Clamp with inverted bounds should return error", op: func() (Matrix, error) { return Clamp(a, 2, -1) }, wantErr: ErrInvalidBounds},
+		{
+			name: "Test Zip with different dimensions should return error",
+			op: func() (Matrix, error) {
+				return Hadamard(a, &FlatMatrix{data: make([]float64, 6), rows: 2, cols: 3})
+			},
+			wantErr: ErrInvalidDimensions,
+		},
+		{name: "Test Div with different dimensions should return error", op: func() (Matrix, error) { return Div(a, &FlatMatrix{rows: 0, cols: 0}) }, wantErr: ErrInvalidDimensions},
+		{name: "Test Zip with nil matrix should return error", op: func() (Matrix, error) { return Hadamard(a, nil) }, wantErr: ErrNilMatrix},
+		{name: "Test Map of nil matrix should return error", op: func() (Matrix, error) { return Abs(nil) }, wantErr: ErrNilMatrix},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op()
+			if err != tt.wantErr {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !reflect.DeepEqual(a.data, []float64{1, -2, 3, -4}) {
+		t.Errorf("operands were modified: %v", a.data)
+	}
+}
+
+func TestElementwise_AnyMatrix(t *testing.T) {
+	square := func(v float64) float64 { return v * v }
+	check := func(m Matrix, dense *FlatMatrix) {
+		t.Helper()
+		want, _ := Hadamard(dense, dense)
+		if got, err := Map(m, square); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("Map(%T) = %v, %v, want %v", m, got, err, want)
+		}
+		if got, err := Hadamard(m, dense); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("Hadamard(%T) = %v, %v, want %v", m, got, err, want)
+		}
+	}
+	for _, m := range sparseFixtures(t) {
+		check(m, mustFlat(t, sparseFixtureDense))
+	}
+	for _, f := range structuredFixtures(t) {
+		check(f.m, f.dense)
+	}
+
+	base := &FlatMatrix{data: []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}, rows: 3, cols: 3}
+	view, err := base.View(1, 1, 2, 2)
+	if err != nil {
+		t.Fatalf("View() error = %v", err)
+	}
+	if got, _ := Map(view, square); !reflect.DeepEqual(got, &FlatMatrix{data: []float64{25, 36, 64, 81}, rows: 2, cols: 2}) {
+		t.Errorf("Map(view) = %v", got)
+	}
+}
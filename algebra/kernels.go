@@ -0,0 +1,41 @@
+package algebra
+
+// The vector kernels below back the element-wise and product operations of FlatMatrix.
+// dot, axpy and scaledAdd dispatch to assembly implementations on CPUs that support them
+// (see kernels_amd64.go) and to these pure Go versions everywhere else. Building with the
+// purego tag forces the pure Go versions.
+
+// dotGeneric returns the inner product of x and y, where len(y) >= len(x).
+func dotGeneric(x, y []float64) float64 {
+	y = y[:len(x)]
+	var s0, s1, s2, s3 float64
+	i := 0
+	for ; i+4 <= len(x); i += 4 {
+		s0 += x[i] * y[i]
+		s1 += x[i+1] * y[i+1]
+		s2 += x[i+2] * y[i+2]
+		s3 += x[i+3] * y[i+3]
+	}
+	for ; i < len(x); i++ {
+		s0 += x[i] * y[i]
+	}
+	return (s0 + s1) + (s2 + s3)
+}
+
+// axpyGeneric computes y += alpha*x element-wise, where len(y) >= len(x).
+func axpyGeneric(alpha float64, x, y []float64) {
+	y = y[:len(x)]
+	for i, v := range x {
+		y[i] += alpha * v
+	}
+}
+
+// scaledAddGeneric computes dst = x + alpha*y element-wise, where x and y hold at least
+// len(dst) elements.
+func scaledAddGeneric(dst, x []float64, alpha float64, y []float64) {
+	x = x[:len(dst)]
+	y = y[:len(dst)]
+	for i := range dst {
+		dst[i] = x[i] + alpha*y[i]
+	}
+}
@@ -0,0 +1,77 @@
+//go:build !purego
+
+package algebra
+
+// useAVX2 reports whether the CPU and operating system support the AVX2 and FMA
+// instructions used by the assembly kernels.
+var useAVX2 = detectAVX2()
+
+// dot returns the inner product of x and y, where len(y) >= len(x).
+func dot(x, y []float64) float64 {
+	if useAVX2 {
+		return dotAVX2(x, y[:len(x)])
+	}
+	return dotGeneric(x, y)
+}
+
+// axpy computes y += alpha*x element-wise, where len(y) >= len(x).
+func axpy(alpha float64, x, y []float64) {
+	if useAVX2 {
+		axpyAVX2(alpha, x, y[:len(x)])
+		return
+	}
+	axpyGeneric(alpha, x, y)
+}
+
+// scaledAdd computes dst = x + alpha*y element-wise, where x and y hold at least len(dst) elements.
+func scaledAdd(dst, x []float64, alpha float64, y []float64) {
+	if useAVX2 {
+		scaledAddAVX2(dst, x[:len(dst)], alpha, y[:len(dst)])
+		return
+	}
+	scaledAddGeneric(dst, x, alpha, y)
+}
+
+// detectAVX2 queries CPUID for AVX2 and FMA support and XGETBV for the operating system
+// saving the YMM registers on context switches.
+func detectAVX2() bool {
+	const (
+		fma     = 1 << 12
+		osxsave = 1 << 27
+		avx     = 1 << 28
+		avx2    = 1 << 5
+		ymm     = 0b110
+	)
+
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return false
+	}
+
+	_, _, ecx, _ := cpuid(1, 0)
+	if ecx&(fma|osxsave|avx) != fma|osxsave|avx {
+		return false
+	}
+
+	if eax, _ := xgetbv(); eax&ymm != ymm {
+		return false
+	}
+
+	_, ebx, _, _ := cpuid(7, 0)
+	return ebx&avx2 != 0
+}
+
+// Implemented in kernels_amd64.s.
+
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+func xgetbv() (eax, edx uint32)
+
+//go:noescape
+func dotAVX2(x, y []float64) float64
+
+//go:noescape
+func axpyAVX2(alpha float64, x, y []float64)
+
+//go:noescape
+func scaledAddAVX2(dst, x []float64, alpha float64, y []float64)
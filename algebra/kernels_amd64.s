@@ -0,0 +1,191 @@
+//go:build !purego
+
+#include "textflag.h"
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func xgetbv() (eax, edx uint32)
+TEXT ·xgetbv(SB), NOSPLIT, $0-8
+	MOVL $0, CX
+	XGETBV
+	MOVL AX, eax+0(FP)
+	MOVL DX, edx+4(FP)
+	RET
+
+// func dotAVX2(x, y []float64) float64
+TEXT ·dotAVX2(SB), NOSPLIT, $0-56
+	MOVQ x_base+0(FP), SI
+	MOVQ x_len+8(FP), CX
+	MOVQ y_base+24(FP), DI
+	VXORPD Y0, Y0, Y0
+	VXORPD Y1, Y1, Y1
+	VXORPD Y2, Y2, Y2
+	VXORPD Y3, Y3, Y3
+
+dot_loop16:
+	CMPQ CX, $16
+	JL   dot_loop4
+	VMOVUPD 0(SI), Y4
+	VMOVUPD 32(SI), Y5
+	VMOVUPD 64(SI), Y6
+	VMOVUPD 96(SI), Y7
+	VFMADD231PD 0(DI), Y4, Y0
+	VFMADD231PD 32(DI), Y5, Y1
+	VFMADD231PD 64(DI), Y6, Y2
+	VFMADD231PD 96(DI), Y7, Y3
+	ADDQ $128, SI
+	ADDQ $128, DI
+	SUBQ $16, CX
+	JMP  dot_loop16
+
+dot_loop4:
+	CMPQ CX, $4
+	JL   dot_reduce
+	VMOVUPD 0(SI), Y4
+	VFMADD231PD 0(DI), Y4, Y0
+	ADDQ $32, SI
+	ADDQ $32, DI
+	SUBQ $4, CX
+	JMP  dot_loop4
+
+dot_reduce:
+	VADDPD Y1, Y0, Y0
+	VADDPD Y3, Y2, Y2
+	VADDPD Y2, Y0, Y0
+	VEXTRACTF128 $1, Y0, X1
+	VADDPD X1, X0, X0
+	VHADDPD X0, X0, X0
+
+dot_loop1:
+	TESTQ CX, CX
+	JE    dot_done
+	VMOVSD 0(SI), X4
+	VFMADD231SD 0(DI), X4, X0
+	ADDQ $8, SI
+	ADDQ $8, DI
+	DECQ CX
+	JMP  dot_loop1
+
+dot_done:
+	VZEROUPPER
+	MOVSD X0, ret+48(FP)
+	RET
+
+// func axpyAVX2(alpha float64, x, y []float64)
+TEXT ·axpyAVX2(SB), NOSPLIT, $0-56
+	VBROADCASTSD alpha+0(FP), Y0
+	MOVQ x_base+8(FP), SI
+	MOVQ x_len+16(FP), CX
+	MOVQ y_base+32(FP), DI
+
+axpy_loop16:
+	CMPQ CX, $16
+	JL   axpy_loop4
+	VMOVUPD 0(DI), Y1
+	VMOVUPD 32(DI), Y2
+	VMOVUPD 64(DI), Y3
+	VMOVUPD 96(DI), Y4
+	VFMADD231PD 0(SI), Y0, Y1
+	VFMADD231PD 32(SI), Y0, Y2
+	VFMADD231PD 64(SI), Y0, Y3
+	VFMADD231PD 96(SI), Y0, Y4
+	VMOVUPD Y1, 0(DI)
+	VMOVUPD Y2, 32(DI)
+	VMOVUPD Y3, 64(DI)
+	VMOVUPD Y4, 96(DI)
+	ADDQ $128, SI
+	ADDQ $128, DI
+	SUBQ $16, CX
+	JMP  axpy_loop16
+
+axpy_loop4:
+	CMPQ CX, $4
+	JL   axpy_loop1
+	VMOVUPD 0(DI), Y1
+	VFMADD231PD 0(SI), Y0, Y1
+	VMOVUPD Y1, 0(DI)
+	ADDQ $32, SI
+	ADDQ $32, DI
+	SUBQ $4, CX
+	JMP  axpy_loop4
+
+axpy_loop1:
+	TESTQ CX, CX
+	JE    axpy_done
+	VMOVSD 0(DI), X1
+	VFMADD231SD 0(SI), X0, X1
+	VMOVSD X1, 0(DI)
+	ADDQ $8, SI
+	ADDQ $8, DI
+	DECQ CX
+	JMP  axpy_loop1
+
+axpy_done:
+	VZEROUPPER
+	RET
+
+// func scaledAddAVX2(dst, x []float64, alpha float64, y []float64)
+TEXT ·scaledAddAVX2(SB), NOSPLIT, $0-80
+	MOVQ dst_base+0(FP), DX
+	MOVQ dst_len+8(FP), CX
+	MOVQ x_base+24(FP), SI
+	VBROADCASTSD alpha+48(FP), Y0
+	MOVQ y_base+56(FP), DI
+
+scaled_loop16:
+	CMPQ CX, $16
+	JL   scaled_loop4
+	VMOVUPD 0(SI), Y1
+	VMOVUPD 32(SI), Y2
+	VMOVUPD 64(SI), Y3
+	VMOVUPD 96(SI), Y4
+	VFMADD231PD 0(DI), Y0, Y1
+	VFMADD231PD 32(DI), Y0, Y2
+	VFMADD231PD 64(DI), Y0, Y3
+	VFMADD231PD 96(DI), Y0, Y4
+	VMOVUPD Y1, 0(DX)
+	VMOVUPD Y2, 32(DX)
+	VMOVUPD Y3, 64(DX)
+	VMOVUPD Y4, 96(DX)
+	ADDQ $128, SI
+	ADDQ $128, DI
+	ADDQ $128, DX
+	SUBQ $16, CX
+	JMP  scaled_loop16
+
+scaled_loop4:
+	CMPQ CX, $4
+	JL   scaled_loop1
+	VMOVUPD 0(SI), Y1
+	VFMADD231PD 0(DI), Y0, Y1
+	VMOVUPD Y1, 0(DX)
+	ADDQ $32, SI
+	ADDQ $32, DI
+	ADDQ $32, DX
+	SUBQ $4, CX
+	JMP  scaled_loop4
+
+scaled_loop1:
+	TESTQ CX, CX
+	JE    scaled_done
+	VMOVSD 0(SI), X1
+	VFMADD231SD 0(DI), X0, X1
+	VMOVSD X1, 0(DX)
+	ADDQ $8, SI
+	ADDQ $8, DI
+	ADDQ $8, DX
+	DECQ CX
+	JMP  scaled_loop1
+
+scaled_done:
+	VZEROUPPER
+	RET
@@ -0,0 +1,18 @@
+//go:build !amd64 || purego
+
+package algebra
+
+// dot returns the inner product of x and y, where len(y) >= len(x).
+func dot(x, y []float64) float64 {
+	return dotGeneric(x, y)
+}
+
+// axpy computes y += alpha*x element-wise, where len(y) >= len(x).
+func axpy(alpha float64, x, y []float64) {
+	axpyGeneric(alpha, x, y)
+}
+
+// scaledAdd computes dst = x + alpha*y element-wise, where x and y hold at least len(dst) elements.
+func scaledAdd(dst, x []float64, alpha float64, y []float64) {
+	scaledAddGeneric(dst, x, alpha, y)
+}
@@ -0,0 +1,115 @@
+package algebra
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func randomSlice(r *rand.Rand, n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = r.Float64()*2 - 1
+	}
+	return s
+}
+
+func TestKernels_MatchGeneric(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var lengths []int
+	for n := 0; n <= 40; n++ {
+		lengths = append(lengths, n)
+	}
+	lengths = append(lengths, 255, 256, 1000, 4099)
+
+	for _, n := range lengths {
+		t.Run(fmt.Sprintf("Test kernels with %d elements", n), func(t *testing.T) {
+			x, y := randomSlice(r, n), randomSlice(r, n)
+			alpha := r.Float64()*4 - 2
+			tol := 1e-12 * float64(n+1)
+
+			if got, want := dot(x, y), dotGeneric(x, y); math.Abs(got-want) > tol {
+				t.Errorf("dot() = %v, want %v", got, want)
+			}
+
+			got := append([]float64(nil), y...)
+			want := append([]float64(nil), y...)
+			axpy(alpha, x, got)
+			axpyGeneric(alpha, x, want)
+			for i := range want {
+				if math.Abs(got[i]-want[i]) > 1e-12 {
+					t.Fatalf("axpy()[%d] = %v, want %v", i, got[i], want[i])
+				}
+			}
+
+			got, want = make([]float64, n), make([]float64, n)
+			scaledAdd(got, x, alpha, y)
+			scaledAddGeneric(want, x, alpha, y)
+			for i := range want {
+				if math.Abs(got[i]-want[i]) > 1e-12 {
+					t.Fatalf("scaledAdd()[%d] = %v, want %v", i, got[i], want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestKernels_LongerOperands(t *testing.T) {
+	x := []float64{1, 2, 3}
+	y := []float64{4, 5, 6, 100}
+
+	if got := dot(x, y); got != 32 {
+		t.Errorf("dot() = %v, want 32", got)
+	}
+
+	axpy(2, x, y)
+	if want := []float64{6, 9, 12, 100}; fmt.Sprint(y) != fmt.Sprint(want) {
+		t.Errorf("axpy() = %v, want %v", y, want)
+	}
+
+	dst := make([]float64, 2)
+	scaledAdd(dst, x, -1, y)
+	if want := []float64{-5, -7}; fmt.Sprint(dst) != fmt.Sprint(want) {
+		t.Errorf("scaledAdd() = %v, want %v", dst, want)
+	}
+}
+
+func BenchmarkKernels(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{64, 4096} {
+		x, y := randomSlice(r, n), randomSlice(r, n)
+		dst := make([]float64, n)
+
+		b.Run(fmt.Sprintf("dot-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = dot(x, y)
+			}
+		})
+		b.Run(fmt.Sprintf("dot-generic-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = dotGeneric(x, y)
+			}
+		})
+		b.Run(fmt.Sprintf("axpy-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				axpy(1e-9, x, dst)
+			}
+		})
+		b.Run(fmt.Sprintf("axpy-generic-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				axpyGeneric(1e-9, x, dst)
+			}
+		})
+		b.Run(fmt.Sprintf("scaled-add-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				scaledAdd(dst, x, 0.5, y)
+			}
+		})
+		b.Run(fmt.Sprintf("scaled-add-generic-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				scaledAddGeneric(dst, x, 0.5, y)
+			}
+		})
+	}
+}
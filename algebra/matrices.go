@@ -19,6 +19,51 @@ var (
 
 	// ErrMulDimensions indicates that matrix dimensions are incompatible for multiplication.
 	ErrMulDimensions = errors.New("invalid dimensions for multiplication")
+
+	// ErrSingularMatrix indicates that a matrix is singular (or numerically close to it) and cannot be inverted.
+	ErrSingularMatrix = errors.New("matrix is singular")
+
+	// ErrNotSquare indicates that an operation requiring a square matrix received a rectangular one.
+	ErrNotSquare = errors.New("matrix is not square")
+
+	// ErrNotSymmetric indicates that an operation requiring a symmetric matrix received a non-symmetric one.
+	ErrNotSymmetric = errors.New("matrix is not symmetric")
+
+	// ErrNotPositiveDefinite indicates that a matrix is not symmetric positive-definite.
+	ErrNotPositiveDefinite = errors.New("matrix is not symmetric positive-definite")
+
+	// ErrNoConvergence indicates that an iterative algorithm did not converge within its iteration limit.
+	ErrNoConvergence = errors.New("algorithm did not converge")
+
+	// ErrNilBackend is returned when a nil Backend is registered.
+	ErrNilBackend = errors.New("backend is nil")
+
+	// ErrBackendExists indicates that a Backend is already registered under the given name.
+	ErrBackendExists = errors.New("backend already registered")
+
+	// ErrUnknownBackend indicates that no Backend is registered under the given name.
+	ErrUnknownBackend = errors.New("unknown backend")
+
+	// ErrInvalidSparseStructure indicates that the arrays describing a sparse matrix are inconsistent.
+	ErrInvalidSparseStructure = errors.New("invalid sparse matrix structure")
+
+	// ErrZeroVector indicates that an operation requiring a nonzero vector received a zero one.
+	ErrZeroVector = errors.New("vector is zero")
+
+	// ErrInvalidNorm indicates that the requested norm is not defined.
+	ErrInvalidNorm = errors.New("invalid norm")
+
+	// ErrInvalidAxis indicates that a tensor axis is out of range or repeated.
+	ErrInvalidAxis = errors.New("invalid axis")
+
+	// ErrNotBroadcastable indicates that two tensor shapes cannot be broadcast together.
+	ErrNotBroadcastable = errors.New("shapes cannot be broadcast")
+
+	// ErrInvalidEinsum indicates a malformed Einstein summation specification.
+	ErrInvalidEinsum = errors.New("invalid einsum specification")
+
+	// ErrInvalidBounds indicates that a lower bound is greater than its upper bound.
+	ErrInvalidBounds = errors.New("lower bound exceeds upper bound")
 )
 
 // Matrix defines a general interface for matrix operations.
@@ -62,6 +107,38 @@ type Matrix interface {
 	Transpose() Matrix
 }
 
+// MutableMatrix extends Matrix with operations that modify the matrix in place.
+// They avoid allocating a new matrix for every update in performance sensitive loops.
+type MutableMatrix interface {
+	Matrix
+
+	// Set assigns v to the element at row i and column j.
+	// Returns an error if the indices are out of bounds.
+	Set(i, j int, v float64) error
+
+	// MustSet assigns v to the element at row i and column j, panicking if indices are out of bounds.
+	MustSet(i, j int, v float64)
+
+	// SetRow replaces the elements of row i with the given values.
+	// Returns an error if the index is out of bounds or the length does not match the number of columns.
+	SetRow(i int, row []float64) error
+
+	// SetCol replaces the elements of column j with the given values.
+	// Returns an error if the index is out of bounds or the length does not match the number of rows.
+	SetCol(j int, col []float64) error
+
+	// AddInPlace adds another matrix element-wise into the current matrix.
+	// Returns an error if dimensions do not match.
+	AddInPlace(Matrix) error
+
+	// SubInPlace subtracts another matrix element-wise from the current matrix.
+	// Returns an error if dimensions do not match.
+	SubInPlace(Matrix) error
+
+	// ScaleInPlace multiplies each element of the current matrix by the given scalar.
+	ScaleInPlace(float64)
+}
+
 // FlatMatrix is a concrete implementation of the Matrix interface.
 // It stores matrix data as a flat slice for efficient memory access and computation.
 type FlatMatrix struct {
@@ -95,41 +172,11 @@ func (m *FlatMatrix) Empty() bool {
 }
 
 func (m *FlatMatrix) Add(other Matrix) (Matrix, error) {
-	if other == nil {
-		return nil, ErrNilMatrix
-	}
-
-	if !m.CompareDimensions(other) {
-		return nil, ErrInvalidDimensions
-	}
-
-	result := make([]float64, m.rows*m.cols)
-	for i := 0; i < m.rows; i++ {
-		for j := 0; j < m.cols; j++ {
-			result[i*m.cols+j] = m.MustAt(i, j) + other.MustAt(i, j)
-		}
-	}
-
-	return NewMatrixFlat(result, m.rows, m.cols)
+	return addScaled(m, other, 1)
 }
 
 func (m *FlatMatrix) Sub(other Matrix) (Matrix, error) {
-	if other == nil {
-		return nil, ErrNilMatrix
-	}
-
-	if !m.CompareDimensions(other) {
-		return nil, ErrInvalidDimensions
-	}
-
-	result := make([]float64, m.rows*m.cols)
-	for i := 0; i < m.rows; i++ {
-		for j := 0; j < m.cols; j++ {
-			result[i*m.cols+j] = m.MustAt(i, j) - other.MustAt(i, j)
-		}
-	}
-
-	return NewMatrixFlat(result, m.rows, m.cols)
+	return addScaled(m, other, -1)
 }
 
 func (m *FlatMatrix) ScalarMul(scalar float64) (Matrix, error) {
@@ -138,39 +185,18 @@ func (m *FlatMatrix) ScalarMul(scalar float64) (Matrix, error) {
 	}
 
 	result := make([]float64, m.rows*m.cols)
-	for i := 0; i < len(m.data); i++ {
-		result[i] = m.data[i] * scalar
-	}
+	backend().Axpy(scalar, m.data, result)
 	return NewMatrixFlat(result, m.rows, m.cols)
 }
 
 func (m *FlatMatrix) Mul(other Matrix) (Matrix, error) {
-	if other == nil {
-		return nil, ErrNilMatrix
-	}
-
-	if m.Cols() != other.Rows() {
-		return nil, ErrMulDimensions
-	}
-
-	nm, err := NewMatrixZero(m.Rows(), other.Cols())
-	if err != nil {
-		panic(err)
-	}
-
-	result := nm.(*FlatMatrix)
-
-	for i := 0; i < m.Rows(); i++ {
-		for j := 0; j < other.Cols(); j++ {
-			var sum float64
-			for k := 0; k < m.Cols(); k++ {
-				sum += m.MustAt(i, k) * other.MustAt(k, j)
-			}
-			result.data[i*result.cols+j] = sum
-		}
-	}
+	return mul(m, other)
+}
 
-	return result, nil
+// MulVec returns the product of the matrix and the column vector x, which must have Cols()
+// elements.
+func (m *FlatMatrix) MulVec(x []float64) ([]float64, error) {
+	return mulVec(m, x)
 }
 
 func (m *FlatMatrix) CompareDimensions(other Matrix) bool {
@@ -203,6 +229,147 @@ func (m *FlatMatrix) Transpose() Matrix {
 	return result
 }
 
+// Trace returns the sum of the diagonal elements of a square matrix.
+func Trace(m Matrix) (float64, error) {
+	if m == nil {
+		return 0, ErrNilMatrix
+	}
+
+	if m.Rows() != m.Cols() {
+		return 0, ErrNotSquare
+	}
+
+	var sum float64
+	for i := 0; i < m.Rows(); i++ {
+		sum += m.MustAt(i, i)
+	}
+	return sum, nil
+}
+
+func (m *FlatMatrix) Set(i, j int, v float64) error {
+	if i < 0 || i >= m.rows || j < 0 || j >= m.cols {
+		return ErrorIndexOutOfBounds
+	}
+	m.data[i*m.cols+j] = v
+	return nil
+}
+
+func (m *FlatMatrix) MustSet(i, j int, v float64) {
+	if err := m.Set(i, j, v); err != nil {
+		panic(err)
+	}
+}
+
+func (m *FlatMatrix) SetRow(i int, row []float64) error {
+	if i < 0 || i >= m.rows {
+		return ErrorIndexOutOfBounds
+	}
+
+	if len(row) != m.cols {
+		return ErrInvalidDimensions
+	}
+
+	copy(m.data[i*m.cols:(i+1)*m.cols], row)
+	return nil
+}
+
+func (m *FlatMatrix) SetCol(j int, col []float64) error {
+	if j < 0 || j >= m.cols {
+		return ErrorIndexOutOfBounds
+	}
+
+	if len(col) != m.rows {
+		return ErrInvalidDimensions
+	}
+
+	for i, v := range col {
+		m.data[i*m.cols+j] = v
+	}
+	return nil
+}
+
+func (m *FlatMatrix) AddInPlace(other Matrix) error {
+	return m.addScaledInPlace(other, 1)
+}
+
+func (m *FlatMatrix) SubInPlace(other Matrix) error {
+	return m.addScaledInPlace(other, -1)
+}
+
+func (m *FlatMatrix) ScaleInPlace(scalar float64) {
+	scaleRows(scalar, m.data, m.rows, m.cols, m.cols)
+}
+
+// addScaledInPlace adds alpha times other to the current matrix element-wise.
+func (m *FlatMatrix) addScaledInPlace(other Matrix, alpha float64) error {
+	if other == nil {
+		return ErrNilMatrix
+	}
+
+	if !m.CompareDimensions(other) {
+		return ErrInvalidDimensions
+	}
+
+	if o, ok := other.(*FlatMatrix); ok {
+		backend().Axpy(alpha, o.data, m.data)
+		return nil
+	}
+
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.cols; j++ {
+			m.data[i*m.cols+j] += alpha * other.MustAt(i, j)
+		}
+	}
+	return nil
+}
+
+// scaleRows multiplies the rows x cols matrix stored row-major in data with row stride ld by
+// alpha in place, through the Scal of the active backend.
+func scaleRows(alpha float64, data []float64, rows, cols, ld int) {
+	if rows == 0 || cols == 0 {
+		return
+	}
+
+	be := backend()
+	for i := 0; i < rows; i++ {
+		be.Scal(alpha, data[i*ld:i*ld+cols])
+	}
+}
+
+// addScaled returns a + alpha*b as a new FlatMatrix, for any pair of Matrix implementations.
+func addScaled(a, b Matrix, alpha float64) (Matrix, error) {
+	if b == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if !a.CompareDimensions(b) {
+		return nil, ErrInvalidDimensions
+	}
+
+	rows, cols := a.Rows(), a.Cols()
+	result := make([]float64, rows*cols)
+
+	ad, lda, aok := strided(a)
+	bd, ldb, bok := strided(b)
+	if aok && bok {
+		be := backend()
+		for i := 0; i < rows; i++ {
+			row := result[i*cols : (i+1)*cols]
+			copy(row, ad[i*lda:])
+			be.Axpy(alpha, bd[i*ldb:i*ldb+cols], row)
+		}
+		return NewMatrixFlat(result, rows, cols)
+	}
+
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			result[i*cols+j] = a.MustAt(i, j) + alpha*b.MustAt(i, j)
+		}
+	}
+
+	return NewMatrixFlat(result, rows, cols)
+}
+
 func NewMatrix(data [][]float64) (Matrix, error) {
 	if !isRectangular(data) {
 		return nil, ErrNotRectangular
@@ -1109,3 +1109,371 @@ func Test_flatten(t *testing.T) {
 		})
 	}
 }
+
+func TestTrace(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       Matrix
+		want    float64
+		wantErr error
+	}{
+		{
+			name: "Test trace of 2x2 matrix",
+			m: &FlatMatrix{
+				data: []float64{1, 2, 3, 4},
+				rows: 2,
+				cols: 2,
+			},
+			want: 5,
+		},
+		{
+			name: "Test trace of 3x3 matrix",
+			m: &FlatMatrix{
+				data: []float64{1, 2, 3, 4, 5, 6, 7, 8, 9},
+				rows: 3,
+				cols: 3,
+			},
+			want: 15,
+		},
+		{
+			name: "Test trace of empty matrix",
+			m: &FlatMatrix{
+				data: []float64{},
+				rows: 0,
+				cols: 0,
+			},
+			want: 0,
+		},
+		{
+			name: "Test trace of rectangular matrix should return error",
+			m: &FlatMatrix{
+				data: []float64{1, 2, 3, 4, 5, 6},
+				rows: 2,
+				cols: 3,
+			},
+			wantErr: ErrNotSquare,
+		},
+		{
+			name:    "Test trace of nil matrix should return error",
+			m:       nil,
+			wantErr: ErrNilMatrix,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Trace(tt.m)
+			if err != tt.wantErr {
+				t.Errorf("Trace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Trace() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMatrix_Set(t *testing.T) {
+	type args struct {
+		i int
+		j int
+		v float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want []float64
+		err  error
+	}{
+		{
+			name: "Test set 2x2 at 0,1",
+			args: args{i: 0, j: 1, v: 9},
+			want: []float64{1, 9, 3, 4},
+		},
+		{
+			name: "Test set 2x2 at 1,0",
+			args: args{i: 1, j: 0, v: -1},
+			want: []float64{1, 2, -1, 4},
+		},
+		{
+			name: "Test set 2x2 at 2,0 should return ErrIndexOutOfRange",
+			args: args{i: 2, j: 0, v: 9},
+			want: []float64{1, 2, 3, 4},
+			err:  ErrorIndexOutOfBounds,
+		},
+		{
+			name: "Test set 2x2 at 0,-1 should return ErrIndexOutOfRange",
+			args: args{i: 0, j: -1, v: 9},
+			want: []float64{1, 2, 3, 4},
+			err:  ErrorIndexOutOfBounds,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &FlatMatrix{
+				data: []float64{1, 2, 3, 4},
+				rows: 2,
+				cols: 2,
+			}
+			if err := m.Set(tt.args.i, tt.args.j, tt.args.v); err != tt.err {
+				t.Errorf("FlatMatrix.Set() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(m.data, tt.want) {
+				t.Errorf("FlatMatrix.Set() = %v, want %v", m.data, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMatrix_MustSet(t *testing.T) {
+	m := &FlatMatrix{
+		data: []float64{1, 2, 3, 4},
+		rows: 2,
+		cols: 2,
+	}
+	m.MustSet(1, 1, 5)
+	if got := m.MustAt(1, 1); got != 5 {
+		t.Errorf("FlatMatrix.MustSet() = %v, want %v", got, 5)
+	}
+
+	defer func() {
+		if r := recover(); r != ErrorIndexOutOfBounds {
+			t.Errorf("FlatMatrix.MustSet() panic = %v, want %v", r, ErrorIndexOutOfBounds)
+		}
+	}()
+	m.MustSet(2, 2, 5)
+}
+
+func TestFlatMatrix_SetRow(t *testing.T) {
+	type args struct {
+		i   int
+		row []float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want []float64
+		err  error
+	}{
+		{
+			name: "Test set first row of 2x3 matrix",
+			args: args{i: 0, row: []float64{7, 8, 9}},
+			want: []float64{7, 8, 9, 4, 5, 6},
+		},
+		{
+			name: "Test set last row of 2x3 matrix",
+			args: args{i: 1, row: []float64{7, 8, 9}},
+			want: []float64{1, 2, 3, 7, 8, 9},
+		},
+		{
+			name: "Test set row out of bounds should return error",
+			args: args{i: 2, row: []float64{7, 8, 9}},
+			want: []float64{1, 2, 3, 4, 5, 6},
+			err:  ErrorIndexOutOfBounds,
+		},
+		{
+			name: "Test set row with wrong length should return error",
+			args: args{i: 0, row: []float64{7, 8}},
+			want: []float64{1, 2, 3, 4, 5, 6},
+			err:  ErrInvalidDimensions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &FlatMatrix{
+				data: []float64{1, 2, 3, 4, 5, 6},
+				rows: 2,
+				cols: 3,
+			}
+			if err := m.SetRow(tt.args.i, tt.args.row); err != tt.err {
+				t.Errorf("FlatMatrix.SetRow() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(m.data, tt.want) {
+				t.Errorf("FlatMatrix.SetRow() = %v, want %v", m.data, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMatrix_SetCol(t *testing.T) {
+	type args struct {
+		j   int
+		col []float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want []float64
+		err  error
+	}{
+		{
+			name: "Test set first column of 2x3 matrix",
+			args: args{j: 0, col: []float64{7, 8}},
+			want: []float64{7, 2, 3, 8, 5, 6},
+		},
+		{
+			name: "Test set last column of 2x3 matrix",
+			args: args{j: 2, col: []float64{7, 8}},
+			want: []float64{1, 2, 7, 4, 5, 8},
+		},
+		{
+			name: "Test set column out of bounds should return error",
+			args: args{j: 3, col: []float64{7, 8}},
+			want: []float64{1, 2, 3, 4, 5, 6},
+			err:  ErrorIndexOutOfBounds,
+		},
+		{
+			name: "Test set column with wrong length should return error",
+			args: args{j: 0, col: []float64{7, 8, 9}},
+			want: []float64{1, 2, 3, 4, 5, 6},
+			err:  ErrInvalidDimensions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &FlatMatrix{
+				data: []float64{1, 2, 3, 4, 5, 6},
+				rows: 2,
+				cols: 3,
+			}
+			if err := m.SetCol(tt.args.j, tt.args.col); err != tt.err {
+				t.Errorf("FlatMatrix.SetCol() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(m.data, tt.want) {
+				t.Errorf("FlatMatrix.SetCol() = %v, want %v", m.data, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMatrix_AddInPlace(t *testing.T) {
+	tests := []struct {
+		name  string
+		other Matrix
+		want  []float64
+		err   error
+	}{
+		{
+			name: "Test adding 2x2 matrix in place",
+			other: &FlatMatrix{
+				data: []float64{5, 6, 7, 8},
+				rows: 2,
+				cols: 2,
+			},
+			want: []float64{6, 8, 10, 12},
+		},
+		{
+			name: "Test adding matrix with different dimensions should return error",
+			other: &FlatMatrix{
+				data: []float64{1, 2, 3},
+				rows: 1,
+				cols: 3,
+			},
+			want: []float64{1, 2, 3, 4},
+			err:  ErrInvalidDimensions,
+		},
+		{
+			name:  "Test adding nil matrix should return error",
+			other: nil,
+			want:  []float64{1, 2, 3, 4},
+			err:   ErrNilMatrix,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &FlatMatrix{
+				data: []float64{1, 2, 3, 4},
+				rows: 2,
+				cols: 2,
+			}
+			if err := m.AddInPlace(tt.other); err != tt.err {
+				t.Errorf("FlatMatrix.AddInPlace() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(m.data, tt.want) {
+				t.Errorf("FlatMatrix.AddInPlace() = %v, want %v", m.data, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMatrix_SubInPlace(t *testing.T) {
+	tests := []struct {
+		name  string
+		other Matrix
+		want  []float64
+		err   error
+	}{
+		{
+			name: "Test subtracting 2x2 matrix in place",
+			other: &FlatMatrix{
+				data: []float64{4, 3, 2, 1},
+				rows: 2,
+				cols: 2,
+			},
+			want: []float64{-3, -1, 1, 3},
+		},
+		{
+			name: "Test subtracting matrix with different dimensions should return error",
+			other: &FlatMatrix{
+				data: []float64{1, 2, 3},
+				rows: 3,
+				cols: 1,
+			},
+			want: []float64{1, 2, 3, 4},
+			err:  ErrInvalidDimensions,
+		},
+		{
+			name:  "Test subtracting nil matrix should return error",
+			other: nil,
+			want:  []float64{1, 2, 3, 4},
+			err:   ErrNilMatrix,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &FlatMatrix{
+				data: []float64{1, 2, 3, 4},
+				rows: 2,
+				cols: 2,
+			}
+			if err := m.SubInPlace(tt.other); err != tt.err {
+				t.Errorf("FlatMatrix.SubInPlace() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(m.data, tt.want) {
+				t.Errorf("FlatMatrix.SubInPlace() = %v, want %v", m.data, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMatrix_ScaleInPlace(t *testing.T) {
+	tests := []struct {
+		name   string
+		scalar float64
+		want   []float64
+	}{
+		{
+			name:   "Test scaling 2x2 matrix by 2 in place",
+			scalar: 2,
+			want:   []float64{2, 4, 6, 8},
+		},
+		{
+			name:   "Test scaling 2x2 matrix by -0.5 in place",
+			scalar: -0.5,
+			want:   []float64{-0.5, -1, -1.5, -2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &FlatMatrix{
+				data: []float64{1, 2, 3, 4},
+				rows: 2,
+				cols: 2,
+			}
+			m.ScaleInPlace(tt.scalar)
+			if !reflect.DeepEqual(m.data, tt.want) {
+				t.Errorf("FlatMatrix.ScaleInPlace() = %v, want %v", m.data, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package algebra
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// mulBlockSize is the edge of the square tiles the multiplication kernel works on.
+	// Three 64x64 tiles of float64 fit comfortably in a typical L2 cache.
+	mulBlockSize = 64
+
+	// mulParallelThreshold is the number of multiply-adds below which spawning goroutines
+	// costs more than it saves and the kernel runs on the calling goroutine.
+	mulParallelThreshold = 1 << 18
+)
+
+// mulWorkers is the configured number of goroutines used by matrix multiplication.
+// Zero selects runtime.GOMAXPROCS.
+var mulWorkers atomic.Int64
+
+// SetMulWorkers sets the maximum number of goroutines used to multiply matrices.
+// A value of zero or less restores the default, which is runtime.GOMAXPROCS(0).
+func SetMulWorkers(n int) {
+	mulWorkers.Store(int64(max(n, 0)))
+}
+
+// MulWorkers returns the maximum number of goroutines used to multiply matrices.
+func MulWorkers() int {
+	if n := mulWorkers.Load(); n > 0 {
+		return int(n)
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// mul returns the product a*b as a new FlatMatrix, for any pair of Matrix implementations.
+// Operands backed by contiguous rows (FlatMatrix and MatrixView) go through the Gemm of the
+// active backend, anything else falls back to element-wise access.
+func mul(a, b Matrix) (Matrix, error) {
+	if b == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if a.Cols() != b.Rows() {
+		return nil, ErrMulDimensions
+	}
+
+	ad, lda, aok := strided(a)
+	bd, ldb, bok := strided(b)
+	if !aok || !bok {
+		return mulNaive(a, b)
+	}
+
+	rows, cols := a.Rows(), b.Cols()
+	result := make([]float64, rows*cols)
+	backend().Gemm(rows, cols, a.Cols(), 1, ad, lda, bd, ldb, 0, result, cols)
+	return NewMatrixFlat(result, rows, cols)
+}
+
+// mulVec returns the product a*x for the matrix a and the vector x with a.Cols() elements.
+func mulVec(a Matrix, x []float64) ([]float64, error) {
+	if len(x) != a.Cols() {
+		return nil, ErrInvalidDimensions
+	}
+
+	rows, cols := a.Rows(), a.Cols()
+	result := make([]float64, rows)
+	if ad, lda, ok := strided(a); ok {
+		if cols > 0 {
+			backend().Gemv(rows, cols, 1, ad, lda, x, 0, result)
+		}
+		return result, nil
+	}
+
+	for i := 0; i < rows; i++ {
+		var sum float64
+		for j := 0; j < cols; j++ {
+			sum += a.MustAt(i, j) * x[j]
+		}
+		result[i] = sum
+	}
+	return result, nil
+}
+
+// mulNaive returns the product a*b using the textbook triple loop over MustAt.
+func mulNaive(a, b Matrix) (Matrix, error) {
+	rows, cols, inner := a.Rows(), b.Cols(), a.Cols()
+	result := make([]float64, rows*cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			var sum float64
+			for k := 0; k < inner; k++ {
+				sum += a.MustAt(i, k) * b.MustAt(k, j)
+			}
+			result[i*cols+j] = sum
+		}
+	}
+
+	return NewMatrixFlat(result, rows, cols)
+}
+
+// strided exposes the row-major storage of m, starting at its first element, together with
+// its row stride. It reports false for implementations without such storage.
+func strided(m Matrix) ([]float64, int, bool) {
+	switch v := m.(type) {
+	case *FlatMatrix:
+		return v.data, v.cols, true
+	case *MatrixView:
+		// Empty views taken at the far corner may point one row past the storage.
+		return v.data[min(v.offset, len(v.data)):], v.stride, true
+	}
+	return nil, 0, false
+}
+
+// gemm accumulates alpha times the product of the m x k matrix a and the k x n matrix b into
+// the m x n matrix c, all stored row-major with row strides lda, ldb and ldc. b is first transposed
+// so the innermost loop is a dot product over two contiguous slices, then the output is
+// computed tile by tile, with bands of rows of c spread across goroutines.
+func gemm(m, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) {
+	if m == 0 || n == 0 || k == 0 {
+		return
+	}
+
+	bt := make([]float64, n*k)
+	for p := 0; p < k; p++ {
+		for j, v := range b[p*ldb : p*ldb+n] {
+			bt[j*k+p] = v
+		}
+	}
+
+	blocks := (m + mulBlockSize - 1) / mulBlockSize
+	workers := min(MulWorkers(), blocks)
+	if workers <= 1 || m*n*k < mulParallelThreshold {
+		gemmRows(0, m, n, k, alpha, a, lda, bt, c, ldc)
+		return
+	}
+
+	band := (blocks + workers - 1) / workers * mulBlockSize
+	var wg sync.WaitGroup
+	for lo := 0; lo < m; lo += band {
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			gemmRows(lo, hi, n, k, alpha, a, lda, bt, c, ldc)
+		}(lo, min(lo+band, m))
+	}
+	wg.Wait()
+}
+
+// gemmRows computes rows lo through hi-1 of c += alpha*a*b, where bt is b transposed and packed
+// into a contiguous n x k slice.
+func gemmRows(lo, hi, n, k int, alpha float64, a []float64, lda int, bt []float64, c []float64, ldc int) {
+	for kk := 0; kk < k; kk += mulBlockSize {
+		kEnd := min(kk+mulBlockSize, k)
+		for jj := 0; jj < n; jj += mulBlockSize {
+			jEnd := min(jj+mulBlockSize, n)
+			for ii := lo; ii < hi; ii += mulBlockSize {
+				iEnd := min(ii+mulBlockSize, hi)
+				for i := ii; i < iEnd; i++ {
+					ai := a[i*lda+kk : i*lda+kEnd]
+					ci := c[i*ldc : i*ldc+n]
+					for j := jj; j < jEnd; j++ {
+						ci[j] += alpha * dot(ai, bt[j*k+kk:j*k+kEnd])
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package algebra
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func randomMatrix(r *rand.Rand, rows, cols int) *FlatMatrix {
+	data := make([]float64, rows*cols)
+	for i := range data {
+		data[i] = r.Float64()*2 - 1
+	}
+	return &FlatMatrix{data: data, rows: rows, cols: cols}
+}
+
+func approxEqualFlat(a, b Matrix, tol float64) bool {
+	if !a.CompareDimensions(b) {
+		return false
+	}
+	for i := 0; i < a.Rows(); i++ {
+		for j := 0; j < a.Cols(); j++ {
+			if math.Abs(a.MustAt(i, j)-b.MustAt(i, j)) > tol {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestMul_MatchesNaive(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tests := []struct {
+		rows  int
+		inner int
+		cols  int
+	}{
+		{rows: 1, inner: 1, cols: 1},
+		{rows: 3, inner: 5, cols: 2},
+		{rows: 64, inner: 64, cols: 64},
+		{rows: 65, inner: 63, cols: 130},
+		{rows: 200, inner: 150, cols: 99},
+		{rows: 300, inner: 1, cols: 300},
+		{rows: 1, inner: 500, cols: 1},
+	}
+	for _, workers := range []int{1, 4} {
+		for _, tt := range tests {
+			name := fmt.Sprintf("Test %dx%d times %dx%d with %d workers", tt.rows, tt.inner, tt.inner, tt.cols, workers)
+			t.Run(name, func(t *testing.T) {
+				SetMulWorkers(workers)
+				defer SetMulWorkers(0)
+
+				a := randomMatrix(r, tt.rows, tt.inner)
+				b := randomMatrix(r, tt.inner, tt.cols)
+				got, err := a.Mul(b)
+				if err != nil {
+					t.Fatalf("FlatMatrix.Mul() error = %v", err)
+				}
+				want, _ := mulNaive(a, b)
+				if !approxEqualFlat(got, want, 1e-9) {
+					t.Errorf("FlatMatrix.Mul() differs from the naive product")
+				}
+			})
+		}
+	}
+}
+
+func TestMul_Views(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	a := randomMatrix(r, 90, 80)
+	b := randomMatrix(r, 70, 100)
+
+	av, _ := a.View(5, 10, 40, 30)
+	bv, _ := b.View(20, 7, 30, 50)
+	got, err := av.Mul(bv)
+	if err != nil {
+		t.Fatalf("MatrixView.Mul() error = %v", err)
+	}
+	want, _ := mulNaive(av, bv)
+	if !approxEqualFlat(got, want, 1e-9) {
+		t.Errorf("MatrixView.Mul() differs from the naive product")
+	}
+
+	c := randomMatrix(r, 20, 40)
+	got, err = c.Mul(av)
+	if err != nil {
+		t.Fatalf("FlatMatrix.Mul() error = %v", err)
+	}
+	want, _ = mulNaive(c, av)
+	if !approxEqualFlat(got, want, 1e-9) {
+		t.Errorf("FlatMatrix.Mul() of a view differs from the naive product")
+	}
+
+	empty, _ := a.View(90, 80, 0, 0)
+	if got, err := empty.Mul(empty); err != nil || !got.Empty() {
+		t.Errorf("MatrixView.Mul() of empty views = %v, %v", got, err)
+	}
+}
+
+func TestMulWorkers(t *testing.T) {
+	defer SetMulWorkers(0)
+
+	if got := MulWorkers(); got != runtime.GOMAXPROCS(0) {
+		t.Errorf("MulWorkers() = %v, want %v", got, runtime.GOMAXPROCS(0))
+	}
+	SetMulWorkers(3)
+	if got := MulWorkers(); got != 3 {
+		t.Errorf("MulWorkers() = %v, want %v", got, 3)
+	}
+	SetMulWorkers(-1)
+	if got := MulWorkers(); got != runtime.GOMAXPROCS(0) {
+		t.Errorf("MulWorkers() = %v, want %v", got, runtime.GOMAXPROCS(0))
+	}
+}
+
+func BenchmarkMul(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{128, 512, 1024} {
+		x := randomMatrix(r, n, n)
+		y := randomMatrix(r, n, n)
+
+		b.Run(fmt.Sprintf("naive-%d", n), func(b *testing.B) {
+			if n > 512 {
+				b.Skip("naive multiplication is too slow at this size")
+			}
+			for i := 0; i < b.N; i++ {
+				_, _ = mulNaive(x, y)
+			}
+		})
+		b.Run(fmt.Sprintf("blocked-serial-%d", n), func(b *testing.B) {
+			SetMulWorkers(1)
+			defer SetMulWorkers(0)
+			for i := 0; i < b.N; i++ {
+				_, _ = x.Mul(y)
+			}
+		})
+		b.Run(fmt.Sprintf("blocked-parallel-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = x.Mul(y)
+			}
+		})
+	}
+}
+
+func TestMulVec(t *testing.T) {
+	m := &FlatMatrix{data: []float64{1, 2, 3, 4, 5, 6}, rows: 2, cols: 3}
+	view, _ := m.View(0, 1, 2, 2)
+	tests := []struct {
+		name string
+		m    interface {
+			MulVec(x []float64) ([]float64, error)
+		}
+		x       []float64
+		want    []float64
+		wantErr error
+	}{
+		{
+			name: "Test MulVec of FlatMatrix",
+			m:    m,
+			x:    []float64{1, 0, -1},
+			want: []float64{-2, -2},
+		},
+		{
+			name: "Test MulVec of view",
+			m:    view.(*MatrixView),
+			x:    []float64{2, 1},
+			want: []float64{7, 16},
+		},
+		{
+			name: "Test MulVec of empty matrix",
+			m:    &FlatMatrix{data: []float64{}, rows: 2, cols: 0},
+			x:    []float64{},
+			want: []float64{0, 0},
+		},
+		{
+			name:    "Test MulVec with wrong vector length should return error",
+			m:       m,
+			x:       []float64{1, 2},
+			wantErr: ErrInvalidDimensions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.MulVec(tt.x)
+			if err != tt.wantErr {
+				t.Fatalf("MulVec() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MulVec() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package algebra
+
+import "math"
+
+// Axis selects the lines of a matrix a reduction folds into single values.
+type Axis int
+
+const (
+	// ByRow reduces every row, giving one value per row.
+	ByRow Axis = iota
+
+	// ByCol reduces every column, giving one value per column.
+	ByCol
+)
+
+// Sum returns the sum of every row or column of m.
+func Sum(m Matrix, axis Axis) (Vector, error) {
+	return reduceLines(m, axis, false, func(line []float64) float64 {
+		var sum float64
+		for _, v := range line {
+			sum += v
+		}
+		return sum
+	})
+}
+
+// Prod returns the product of every row or column of m.
+func Prod(m Matrix, axis Axis) (Vector, error) {
+	return reduceLines(m, axis, false, func(line []float64) float64 {
+		prod := 1.0
+		for _, v := range line {
+			prod *= v
+		}
+		return prod
+	})
+}
+
+// Mean returns the arithmetic mean of every row or column of m.
+// Returns ErrInvalidDimensions if the rows or columns are empty.
+func Mean(m Matrix, axis Axis) (Vector, error) {
+	return reduceLines(m, axis, true, mean)
+}
+
+// Var returns the population variance of every row or column of m, the mean squared deviation
+// from its mean. Returns ErrInvalidDimensions if the rows or columns are empty.
+func Var(m Matrix, axis Axis) (Vector, error) {
+	return reduceLines(m, axis, true, func(line []float64) float64 {
+		mu := mean(line)
+		var sum float64
+		for _, v := range line {
+			sum += (v - mu) * (v - mu)
+		}
+		return sum / float64(len(line))
+	})
+}
+
+// Min returns the smallest element of every row or column of m.
+// Returns ErrInvalidDimensions if the rows or columns are empty.
+func Min(m Matrix, axis Axis) (Vector, error) {
+	return reduceLines(m, axis, true, func(line []float64) float64 {
+		return line[argBest(line, func(a, b float64) bool { return a < b })]
+	})
+}
+
+// Max returns the largest element of every row or column of m.
+// Returns ErrInvalidDimensions if the rows or columns are empty.
+func Max(m Matrix, axis Axis) (Vector, error) {
+	return reduceLines(m, axis, true, func(line []float64) float64 {
+		return line[argBest(line, func(a, b float64) bool { return a > b })]
+	})
+}
+
+// ArgMin returns the index of the smallest element of every row or column of m; the column
+// index for rows and the row index for columns. Ties resolve to the first index.
+// Returns ErrInvalidDimensions if the rows or columns are empty.
+func ArgMin(m Matrix, axis Axis) ([]int, error) {
+	return argLines(m, axis, func(a, b float64) bool { return a < b })
+}
+
+// ArgMax returns the index of the largest element of every row or column of m, like ArgMin.
+func ArgMax(m Matrix, axis Axis) ([]int, error) {
+	return argLines(m, axis, func(a, b float64) bool { return a > b })
+}
+
+// reduceLines folds every row or column of m into a single value with f. With nonEmpty set, it
+// returns ErrInvalidDimensions instead of calling f on empty lines.
+func reduceLines(m Matrix, axis Axis, nonEmpty bool, f func(line []float64) float64) (Vector, error) {
+	result := Vector{}
+	err := eachLine(m, axis, nonEmpty, func(line []float64) {
+		result = append(result, f(line))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// argLines returns the index of the element of every row or column of m that is better than
+// all others, which therefore must not be empty.
+func argLines(m Matrix, axis Axis, better func(a, b float64) bool) ([]int, error) {
+	result := []int{}
+	err := eachLine(m, axis, true, func(line []float64) {
+		result = append(result, argBest(line, better))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// eachLine calls f with the elements of every row or column of m. The line slice is
+// reused between calls. With nonEmpty set, it returns ErrInvalidDimensions if m has lines but
+// they are empty.
+func eachLine(m Matrix, axis Axis, nonEmpty bool, f func(line []float64)) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+
+	lines, length := m.Rows(), m.Cols()
+	switch axis {
+	case ByRow:
+	case ByCol:
+		lines, length = length, lines
+	default:
+		return ErrInvalidAxis
+	}
+
+	if nonEmpty && lines > 0 && length == 0 {
+		return ErrInvalidDimensions
+	}
+
+	at := elementAt(m)
+	line := make([]float64, length)
+	for k := 0; k < lines; k++ {
+		for l := range line {
+			if axis == ByRow {
+				line[l] = at(k, l)
+			} else {
+				line[l] = at(l, k)
+			}
+		}
+		f(line)
+	}
+	return nil
+}
+
+// mean returns the arithmetic mean of a non-empty slice.
+func mean(line []float64) float64 {
+	var sum float64
+	for _, v := range line {
+		sum += v
+	}
+	return sum / float64(len(line))
+}
+
+// argBest returns the first index of a non-empty slice whose element is better than all
+// others. A NaN anywhere makes the first NaN the result, so that Min and Max propagate it.
+func argBest(line []float64, better func(a, b float64) bool) int {
+	best := 0
+	for i, v := range line {
+		if math.IsNaN(v) {
+			return i
+		}
+		if better(v, line[best]) {
+			best = i
+		}
+	}
+	return best
+}
@@ -0,0 +1,124 @@
+package algebra
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestReductions(t *testing.T) {
+	m := &FlatMatrix{data: []float64{1, 5, 3, 4, 2, 6}, rows: 2, cols: 3}
+
+	tests := []struct {
+		name   string
+		reduce func(Matrix, Axis) (Vector, error)
+		axis   Axis
+		want   Vector
+	}{
+		{name: "Test row sums", reduce: Sum, axis: ByRow, want: Vector{9, 12}},
+		{name: "Test column sums", reduce: Sum, axis: ByCol, want: Vector{5, 7, 9}},
+		{name: "Test row products", reduce: Prod, axis: ByRow, want: Vector{15, 48}},
+		{name: "Test column products", reduce: Prod, axis: ByCol, want: Vector{4, 10, 18}},
+		{name: "Test row means", reduce: Mean, axis: ByRow, want: Vector{3, 4}},
+		{name: "Test column means", reduce: Mean, axis: ByCol, want: Vector{2.5, 3.5, 4.5}},
+		{name: "Test row variances", reduce: Var, axis: ByRow, want: Vector{8.0 / 3, 8.0 / 3}},
+		{name: "Test column variances", reduce: Var, axis: ByCol, want: Vector{2.25, 2.25, 2.25}},
+		{name: "Test row minima", reduce: Min, axis: ByRow, want: Vector{1, 2}},
+		{name: "Test column minima", reduce: Min, axis: ByCol, want: Vector{1, 2, 3}},
+		{name: "Test row maxima", reduce: Max, axis: ByRow, want: Vector{5, 6}},
+		{name: "Test column maxima", reduce: Max, axis: ByCol, want: Vector{4, 5, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.reduce(m, tt.axis)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if math.Abs(got[i]-tt.want[i]) > 1e-12 {
+					t.Fatalf("got %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestArgReductions(t *testing.T) {
+	m := &FlatMatrix{data: []float64{1, 5, 5, 4, 2, 6, 4, 2, 0}, rows: 3, cols: 3}
+
+	tests := []struct {
+		name   string
+		reduce func(Matrix, Axis) ([]int, error)
+		axis   Axis
+		want   []int
+	}{
+		{name: "Test row argmin", reduce: ArgMin, axis: ByRow, want: []int{0, 1, 2}},
+		{name: "Test column argmin", reduce: ArgMin, axis: ByCol, want: []int{0, 1, 2}},
+		{name: "Test row argmax resolves ties to the first index", reduce: ArgMax, axis: ByRow, want: []int{1, 2, 0}},
+		{name: "Test column argmax resolves ties to the first index", reduce: ArgMax, axis: ByCol, want: []int{1, 0, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.reduce(m, tt.axis)
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestReductions_AnyMatrix(t *testing.T) {
+	for _, m := range sparseFixtures(t) {
+		if got, err := Sum(m, ByRow); err != nil || !reflect.DeepEqual(got, Vector{3, 3, 9}) {
+			t.Errorf("Sum(%T, ByRow) = %v, %v", m, got, err)
+		}
+		if got, err := ArgMax(m, ByCol); err != nil || !reflect.DeepEqual(got, []int{2, 2, 1}) {
+			t.Errorf("ArgMax(%T, ByCol) = %v, %v", m, got, err)
+		}
+	}
+
+	base := &FlatMatrix{data: []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}, rows: 3, cols: 3}
+	view, err := base.View(1, 1, 2, 2)
+	if err != nil {
+		t.Fatalf("View() error = %v", err)
+	}
+	if got, err := Sum(view, ByCol); err != nil || !reflect.DeepEqual(got, Vector{13, 15}) {
+		t.Errorf("Sum(view, ByCol) = %v, %v", got, err)
+	}
+}
+
+func TestReductions_EdgeCases(t *testing.T) {
+	withNaN := &FlatMatrix{data: []float64{1, math.NaN(), 3}, rows: 1, cols: 3}
+	if got, _ := Max(withNaN, ByRow); !math.IsNaN(got[0]) {
+		t.Errorf("Max() = %v, want NaN", got)
+	}
+	if got, _ := ArgMin(withNaN, ByRow); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("ArgMin() = %v, want [1]", got)
+	}
+
+	noCols := &FlatMatrix{data: []float64{}, rows: 2, cols: 0}
+	if got, err := Sum(noCols, ByRow); err != nil || !reflect.DeepEqual(got, Vector{0, 0}) {
+		t.Errorf("Sum() = %v, %v, want [0 0]", got, err)
+	}
+	if got, err := Max(noCols, ByCol); err != nil || !reflect.DeepEqual(got, Vector{}) {
+		t.Errorf("Max() = %v, %v, want []", got, err)
+	}
+	for name, reduce := range map[string]func(Matrix, Axis) (Vector, error){"Mean": Mean, "Var": Var, "Min": Min, "Max": Max} {
+		if _, err := reduce(noCols, ByRow); err != ErrInvalidDimensions {
+			t.Errorf("%s() error = %v, want %v", name, err, ErrInvalidDimensions)
+		}
+	}
+	if _, err := ArgMax(noCols, ByRow); err != ErrInvalidDimensions {
+		t.Errorf("ArgMax() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+
+	if _, err := Sum(withNaN, Axis(2)); err != ErrInvalidAxis {
+		t.Errorf("Sum() error = %v, want %v", err, ErrInvalidAxis)
+	}
+	if _, err := ArgMin(nil, ByRow); err != ErrNilMatrix {
+		t.Errorf("ArgMin() error = %v, want %v", err, ErrNilMatrix)
+	}
+}
@@ -0,0 +1,775 @@
+package algebra
+
+import (
+	"sort"
+	"sync"
+)
+
+// CSRMatrix is a sparse matrix in compressed sparse row format. The column indices of the
+// nonzero elements of row i are indices[indptr[i]:indptr[i+1]], in increasing order, and
+// their values are the matching elements of data. Sparse matrices are immutable: every
+// operation returns a new matrix, possibly sharing storage with its operands.
+type CSRMatrix struct {
+	rows    int
+	cols    int
+	indptr  []int
+	indices []int
+	data    []float64
+}
+
+// CSCMatrix is a sparse matrix in compressed sparse column format. The row indices of the
+// nonzero elements of column j are stored in increasing order, like the column indices of a
+// CSRMatrix row.
+type CSCMatrix struct {
+	// t is the transpose of the matrix in CSR format, which has exactly the CSC layout.
+	t *CSRMatrix
+}
+
+// COOMatrix is a sparse matrix in coordinate format: an unordered list of (row, column,
+// value) triplets, where values of repeated coordinates add up. It is meant for assembling
+// matrices; arithmetic converts it to CSR first. The first call to At sorts the triplets into
+// a cached CSR copy, in O(nnz log nnz) time and O(nnz) extra memory, after which every lookup
+// is a binary search within a row.
+type COOMatrix struct {
+	rows   int
+	cols   int
+	rowIdx []int
+	colIdx []int
+	data   []float64
+
+	// csr is the matrix in CSR format, built once by compressed.
+	csr  *CSRMatrix
+	once sync.Once
+}
+
+// NewCSR returns a rows x cols CSRMatrix built from its row pointers, column indices and
+// values, which are copied. Returns ErrInvalidSparseStructure if the arrays do not describe
+// a valid CSR layout with sorted, unique column indices inside every row.
+func NewCSR(rows, cols int, indptr, indices []int, data []float64) (Matrix, error) {
+	return newCSR(rows, cols, indptr, indices, data)
+}
+
+// NewCSC returns a rows x cols CSCMatrix built from its column pointers, row indices and
+// values, which are copied. Returns ErrInvalidSparseStructure if the arrays do not describe
+// a valid CSC layout with sorted, unique row indices inside every column.
+func NewCSC(rows, cols int, indptr, indices []int, data []float64) (Matrix, error) {
+	t, err := newCSR(cols, rows, indptr, indices, data)
+	if err != nil {
+		return nil, err
+	}
+	return &CSCMatrix{t: t}, nil
+}
+
+// NewCOO returns a rows x cols COOMatrix holding the given triplets, which are copied.
+// Returns an error if the slices differ in length or a coordinate is out of range.
+func NewCOO(rows, cols int, rowIdx, colIdx []int, data []float64) (Matrix, error) {
+	if rows < 0 || cols < 0 || len(rowIdx) != len(data) || len(colIdx) != len(data) {
+		return nil, ErrInvalidDimensions
+	}
+
+	for k := range data {
+		if rowIdx[k] < 0 || rowIdx[k] >= rows || colIdx[k] < 0 || colIdx[k] >= cols {
+			return nil, ErrorIndexOutOfBounds
+		}
+	}
+
+	return &COOMatrix{
+		rows:   rows,
+		cols:   cols,
+		rowIdx: append([]int(nil), rowIdx...),
+		colIdx: append([]int(nil), colIdx...),
+		data:   append([]float64(nil), data...),
+	}, nil
+}
+
+func newCSR(rows, cols int, indptr, indices []int, data []float64) (*CSRMatrix, error) {
+	if rows < 0 || cols < 0 {
+		return nil, ErrInvalidDimensions
+	}
+
+	if len(indptr) != rows+1 || indptr[0] != 0 || indptr[rows] != len(indices) || len(indices) != len(data) {
+		return nil, ErrInvalidSparseStructure
+	}
+
+	for i := 0; i < rows; i++ {
+		if indptr[i] > indptr[i+1] {
+			return nil, ErrInvalidSparseStructure
+		}
+	}
+
+	for i := 0; i < rows; i++ {
+		for p := indptr[i]; p < indptr[i+1]; p++ {
+			j := indices[p]
+			if j < 0 || j >= cols || (p > indptr[i] && j <= indices[p-1]) {
+				return nil, ErrInvalidSparseStructure
+			}
+		}
+	}
+
+	return &CSRMatrix{
+		rows:    rows,
+		cols:    cols,
+		indptr:  append([]int(nil), indptr...),
+		indices: append([]int(nil), indices...),
+		data:    append([]float64(nil), data...),
+	}, nil
+}
+
+// ToCSR converts any Matrix to CSR format, dropping zero elements of dense matrices.
+// m itself is returned when it already is a *CSRMatrix.
+func ToCSR(m Matrix) (*CSRMatrix, error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+
+	switch s := m.(type) {
+	case *CSRMatrix:
+		return s, nil
+	case *CSCMatrix:
+		return s.t.transpose(), nil
+	case *COOMatrix:
+		return csrFromTriplets(s.rows, s.cols, s.rowIdx, s.colIdx, s.data), nil
+	}
+
+	rows, cols := m.Rows(), m.Cols()
+	c := &CSRMatrix{rows: rows, cols: cols, indptr: make([]int, rows+1)}
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			if v := m.MustAt(i, j); v != 0 {
+				c.indices = append(c.indices, j)
+				c.data = append(c.data, v)
+			}
+		}
+		c.indptr[i+1] = len(c.data)
+	}
+	return c, nil
+}
+
+// ToCSC converts any Matrix to CSC format, dropping zero elements of dense matrices.
+// m itself is returned when it already is a *CSCMatrix.
+func ToCSC(m Matrix) (*CSCMatrix, error) {
+	if s, ok := m.(*CSCMatrix); ok {
+		return s, nil
+	}
+
+	c, err := ToCSR(m)
+	if err != nil {
+		return nil, err
+	}
+	return &CSCMatrix{t: c.transpose()}, nil
+}
+
+// ToCOO converts any Matrix to COO format, dropping zero elements of dense matrices.
+// m itself is returned when it already is a *COOMatrix.
+func ToCOO(m Matrix) (*COOMatrix, error) {
+	if s, ok := m.(*COOMatrix); ok {
+		return s, nil
+	}
+
+	c, err := ToCSR(m)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &COOMatrix{
+		rows:   c.rows,
+		cols:   c.cols,
+		rowIdx: make([]int, 0, len(c.data)),
+		colIdx: append([]int(nil), c.indices...),
+		data:   append([]float64(nil), c.data...),
+	}
+	for i := 0; i < c.rows; i++ {
+		for p := c.indptr[i]; p < c.indptr[i+1]; p++ {
+			result.rowIdx = append(result.rowIdx, i)
+		}
+	}
+	return result, nil
+}
+
+// ToFlat returns the elements of any Matrix as a new dense FlatMatrix.
+func ToFlat(m Matrix) (*FlatMatrix, error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+
+	rows, cols := m.Rows(), m.Cols()
+	result := &FlatMatrix{data: make([]float64, rows*cols), rows: rows, cols: cols}
+
+	switch s := m.(type) {
+	case *CSRMatrix:
+		s.scatter(result.data, cols, 1)
+	case *CSCMatrix:
+		s.t.each(func(j, i int, v float64) {
+			result.data[i*cols+j] = v
+		})
+	case *COOMatrix:
+		for k, v := range s.data {
+			result.data[s.rowIdx[k]*cols+s.colIdx[k]] += v
+		}
+	default:
+		for i := 0; i < rows; i++ {
+			for j := 0; j < cols; j++ {
+				result.data[i*cols+j] = m.MustAt(i, j)
+			}
+		}
+	}
+	return result, nil
+}
+
+// NNZ returns the number of stored elements.
+func (c *CSRMatrix) NNZ() int {
+	return len(c.data)
+}
+
+// Raw returns the row pointers, column indices and values backing the matrix. The slices
+// are shared with the matrix and must not be modified.
+func (c *CSRMatrix) Raw() (indptr, indices []int, data []float64) {
+	return c.indptr, c.indices, c.data
+}
+
+// MulVec returns the product of the matrix and the column vector x, which must have Cols()
+// elements.
+func (c *CSRMatrix) MulVec(x []float64) ([]float64, error) {
+	if len(x) != c.cols {
+		return nil, ErrInvalidDimensions
+	}
+
+	result := make([]float64, c.rows)
+	for i := range result {
+		var sum float64
+		for p := c.indptr[i]; p < c.indptr[i+1]; p++ {
+			sum += c.data[p] * x[c.indices[p]]
+		}
+		result[i] = sum
+	}
+	return result, nil
+}
+
+func (c *CSRMatrix) Rows() int {
+	return c.rows
+}
+
+func (c *CSRMatrix) Cols() int {
+	return c.cols
+}
+
+func (c *CSRMatrix) At(i, j int) (float64, error) {
+	if i < 0 || i >= c.rows || j < 0 || j >= c.cols {
+		return 0, ErrorIndexOutOfBounds
+	}
+
+	lo, hi := c.indptr[i], c.indptr[i+1]
+	if p := lo + sort.SearchInts(c.indices[lo:hi], j); p < hi && c.indices[p] == j {
+		return c.data[p], nil
+	}
+	return 0, nil
+}
+
+func (c *CSRMatrix) MustAt(i, j int) (v float64) {
+	var err error
+	if v, err = c.At(i, j); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func (c *CSRMatrix) Empty() bool {
+	return c.rows == 0
+}
+
+func (c *CSRMatrix) Add(other Matrix) (Matrix, error) {
+	return c.addScaled(other, 1)
+}
+
+func (c *CSRMatrix) Sub(other Matrix) (Matrix, error) {
+	return c.addScaled(other, -1)
+}
+
+func (c *CSRMatrix) ScalarMul(scalar float64) (Matrix, error) {
+	return c.scale(scalar), nil
+}
+
+func (c *CSRMatrix) CompareDimensions(other Matrix) bool {
+	if other == nil {
+		return false
+	}
+
+	return c.Rows() == other.Rows() && c.Cols() == other.Cols()
+}
+
+func (c *CSRMatrix) Mul(other Matrix) (Matrix, error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if c.cols != other.Rows() {
+		return nil, ErrMulDimensions
+	}
+
+	if isSparse(other) {
+		o, _ := ToCSR(other)
+		return c.mulSparse(o), nil
+	}
+
+	if od, ldo, ok := strided(other); ok {
+		n := other.Cols()
+		result := make([]float64, c.rows*n)
+		b := backend()
+		c.each(func(i, j int, v float64) {
+			b.Axpy(v, od[j*ldo:j*ldo+n], result[i*n:(i+1)*n])
+		})
+		return NewMatrixFlat(result, c.rows, n)
+	}
+
+	return mulNaive(c, other)
+}
+
+// Transpose returns the transpose in CSC format, sharing storage with c.
+func (c *CSRMatrix) Transpose() Matrix {
+	return &CSCMatrix{t: c}
+}
+
+// addScaled returns c + alpha*other. The result is sparse when other is sparse and a dense
+// FlatMatrix otherwise.
+func (c *CSRMatrix) addScaled(other Matrix, alpha float64) (Matrix, error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if !c.CompareDimensions(other) {
+		return nil, ErrInvalidDimensions
+	}
+
+	if isSparse(other) {
+		o, _ := ToCSR(other)
+		return c.addSparse(o, alpha), nil
+	}
+
+	result, _ := ToFlat(other)
+	scale(alpha, result.data)
+	c.scatter(result.data, c.cols, 1)
+	return result, nil
+}
+
+// addSparse returns c + alpha*o, merging the sorted rows of both operands and dropping
+// elements that cancel out.
+func (c *CSRMatrix) addSparse(o *CSRMatrix, alpha float64) *CSRMatrix {
+	result := &CSRMatrix{
+		rows:    c.rows,
+		cols:    c.cols,
+		indptr:  make([]int, c.rows+1),
+		indices: make([]int, 0, len(c.data)+len(o.data)),
+		data:    make([]float64, 0, len(c.data)+len(o.data)),
+	}
+
+	push := func(j int, v float64) {
+		if v != 0 {
+			result.indices = append(result.indices, j)
+			result.data = append(result.data, v)
+		}
+	}
+
+	for i := 0; i < c.rows; i++ {
+		p, pEnd := c.indptr[i], c.indptr[i+1]
+		q, qEnd := o.indptr[i], o.indptr[i+1]
+		for p < pEnd || q < qEnd {
+			switch {
+			case q == qEnd || (p < pEnd && c.indices[p] < o.indices[q]):
+				push(c.indices[p], c.data[p])
+				p++
+			case p == pEnd || o.indices[q] < c.indices[p]:
+				push(o.indices[q], alpha*o.data[q])
+				q++
+			default:
+				push(c.indices[p], c.data[p]+alpha*o.data[q])
+				p++
+				q++
+			}
+		}
+		result.indptr[i+1] = len(result.data)
+	}
+	return result
+}
+
+// mulSparse returns the sparse product c*o using Gustavson's row-by-row algorithm.
+func (c *CSRMatrix) mulSparse(o *CSRMatrix) *CSRMatrix {
+	result := &CSRMatrix{rows: c.rows, cols: o.cols, indptr: make([]int, c.rows+1)}
+
+	acc := make([]float64, o.cols)
+	seen := make([]int, o.cols)
+	for k := range seen {
+		seen[k] = -1
+	}
+
+	var touched []int
+	for i := 0; i < c.rows; i++ {
+		touched = touched[:0]
+		for p := c.indptr[i]; p < c.indptr[i+1]; p++ {
+			j, v := c.indices[p], c.data[p]
+			for q := o.indptr[j]; q < o.indptr[j+1]; q++ {
+				k := o.indices[q]
+				if seen[k] != i {
+					seen[k] = i
+					acc[k] = 0
+					touched = append(touched, k)
+				}
+				acc[k] += v * o.data[q]
+			}
+		}
+
+		sort.Ints(touched)
+		for _, k := range touched {
+			if acc[k] != 0 {
+				result.indices = append(result.indices, k)
+				result.data = append(result.data, acc[k])
+			}
+		}
+		result.indptr[i+1] = len(result.data)
+	}
+	return result
+}
+
+// scale returns alpha*c, with no stored elements at all when alpha is zero.
+func (c *CSRMatrix) scale(alpha float64) *CSRMatrix {
+	if alpha == 0 {
+		return &CSRMatrix{rows: c.rows, cols: c.cols, indptr: make([]int, c.rows+1)}
+	}
+
+	data := make([]float64, len(c.data))
+	for p, v := range c.data {
+		data[p] = alpha * v
+	}
+	return &CSRMatrix{rows: c.rows, cols: c.cols, indptr: c.indptr, indices: c.indices, data: data}
+}
+
+// transpose returns the transpose of c in CSR format, with freshly allocated storage.
+func (c *CSRMatrix) transpose() *CSRMatrix {
+	result := &CSRMatrix{
+		rows:    c.cols,
+		cols:    c.rows,
+		indptr:  make([]int, c.cols+1),
+		indices: make([]int, len(c.indices)),
+		data:    make([]float64, len(c.data)),
+	}
+
+	for _, j := range c.indices {
+		result.indptr[j+1]++
+	}
+	for j := 0; j < c.cols; j++ {
+		result.indptr[j+1] += result.indptr[j]
+	}
+
+	next := append([]int(nil), result.indptr[:c.cols]...)
+	c.each(func(i, j int, v float64) {
+		result.indices[next[j]] = i
+		result.data[next[j]] = v
+		next[j]++
+	})
+	return result
+}
+
+// each calls f for every stored element, row by row.
+func (c *CSRMatrix) each(f func(i, j int, v float64)) {
+	for i := 0; i < c.rows; i++ {
+		for p := c.indptr[i]; p < c.indptr[i+1]; p++ {
+			f(i, c.indices[p], c.data[p])
+		}
+	}
+}
+
+// scatter adds alpha times the stored elements into the row-major dense slice dst with row
+// stride ld.
+func (c *CSRMatrix) scatter(dst []float64, ld int, alpha float64) {
+	c.each(func(i, j int, v float64) {
+		dst[i*ld+j] += alpha * v
+	})
+}
+
+// NNZ returns the number of stored elements.
+func (c *CSCMatrix) NNZ() int {
+	return c.t.NNZ()
+}
+
+// Raw returns the column pointers, row indices and values backing the matrix. The slices
+// are shared with the matrix and must not be modified.
+func (c *CSCMatrix) Raw() (indptr, indices []int, data []float64) {
+	return c.t.Raw()
+}
+
+// MulVec returns the product of the matrix and the column vector x, which must have Cols()
+// elements.
+func (c *CSCMatrix) MulVec(x []float64) ([]float64, error) {
+	if len(x) != c.Cols() {
+		return nil, ErrInvalidDimensions
+	}
+
+	result := make([]float64, c.Rows())
+	c.t.each(func(j, i int, v float64) {
+		result[i] += v * x[j]
+	})
+	return result, nil
+}
+
+func (c *CSCMatrix) Rows() int {
+	return c.t.cols
+}
+
+func (c *CSCMatrix) Cols() int {
+	return c.t.rows
+}
+
+func (c *CSCMatrix) At(i, j int) (float64, error) {
+	return c.t.At(j, i)
+}
+
+func (c *CSCMatrix) MustAt(i, j int) (v float64) {
+	var err error
+	if v, err = c.At(i, j); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func (c *CSCMatrix) Empty() bool {
+	return c.Rows() == 0
+}
+
+func (c *CSCMatrix) Add(other Matrix) (Matrix, error) {
+	return c.addScaled(other, 1)
+}
+
+func (c *CSCMatrix) Sub(other Matrix) (Matrix, error) {
+	return c.addScaled(other, -1)
+}
+
+func (c *CSCMatrix) ScalarMul(scalar float64) (Matrix, error) {
+	return &CSCMatrix{t: c.t.scale(scalar)}, nil
+}
+
+func (c *CSCMatrix) CompareDimensions(other Matrix) bool {
+	if other == nil {
+		return false
+	}
+
+	return c.Rows() == other.Rows() && c.Cols() == other.Cols()
+}
+
+func (c *CSCMatrix) Mul(other Matrix) (Matrix, error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if c.Cols() != other.Rows() {
+		return nil, ErrMulDimensions
+	}
+
+	// (A*B)ᵀ = Bᵀ*Aᵀ, and the transposes of CSC matrices are their CSR storage.
+	if isSparse(other) {
+		o, _ := ToCSC(other)
+		return &CSCMatrix{t: o.t.mulSparse(c.t)}, nil
+	}
+
+	if od, ldo, ok := strided(other); ok {
+		n := other.Cols()
+		result := make([]float64, c.Rows()*n)
+		b := backend()
+		c.t.each(func(j, i int, v float64) {
+			b.Axpy(v, od[j*ldo:j*ldo+n], result[i*n:(i+1)*n])
+		})
+		return NewMatrixFlat(result, c.Rows(), n)
+	}
+
+	return mulNaive(c, other)
+}
+
+// Transpose returns the transpose in CSR format, sharing storage with c.
+func (c *CSCMatrix) Transpose() Matrix {
+	return c.t
+}
+
+// addScaled returns c + alpha*other. The result is sparse when other is sparse and a dense
+// FlatMatrix otherwise.
+func (c *CSCMatrix) addScaled(other Matrix, alpha float64) (Matrix, error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if !c.CompareDimensions(other) {
+		return nil, ErrInvalidDimensions
+	}
+
+	if isSparse(other) {
+		o, _ := ToCSC(other)
+		return &CSCMatrix{t: c.t.addSparse(o.t, alpha)}, nil
+	}
+
+	result, _ := ToFlat(other)
+	scale(alpha, result.data)
+	c.t.each(func(j, i int, v float64) {
+		result.data[i*result.cols+j] += v
+	})
+	return result, nil
+}
+
+// NNZ returns the number of stored triplets, counting repeated coordinates separately.
+func (c *COOMatrix) NNZ() int {
+	return len(c.data)
+}
+
+// Raw returns the row indices, column indices and values of the stored triplets. The slices
+// are shared with the matrix and must not be modified.
+func (c *COOMatrix) Raw() (rowIdx, colIdx []int, data []float64) {
+	return c.rowIdx, c.colIdx, c.data
+}
+
+// MulVec returns the product of the matrix and the column vector x, which must have Cols()
+// elements.
+func (c *COOMatrix) MulVec(x []float64) ([]float64, error) {
+	if len(x) != c.cols {
+		return nil, ErrInvalidDimensions
+	}
+
+	result := make([]float64, c.rows)
+	for k, v := range c.data {
+		result[c.rowIdx[k]] += v * x[c.colIdx[k]]
+	}
+	return result, nil
+}
+
+func (c *COOMatrix) Rows() int {
+	return c.rows
+}
+
+func (c *COOMatrix) Cols() int {
+	return c.cols
+}
+
+func (c *COOMatrix) At(i, j int) (float64, error) {
+	if i < 0 || i >= c.rows || j < 0 || j >= c.cols {
+		return 0, ErrorIndexOutOfBounds
+	}
+
+	return c.compressed().At(i, j)
+}
+
+func (c *COOMatrix) MustAt(i, j int) (v float64) {
+	var err error
+	if v, err = c.At(i, j); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func (c *COOMatrix) Empty() bool {
+	return c.rows == 0
+}
+
+func (c *COOMatrix) Add(other Matrix) (Matrix, error) {
+	return c.compressed().Add(other)
+}
+
+func (c *COOMatrix) Sub(other Matrix) (Matrix, error) {
+	return c.compressed().Sub(other)
+}
+
+func (c *COOMatrix) ScalarMul(scalar float64) (Matrix, error) {
+	data := make([]float64, len(c.data))
+	for k, v := range c.data {
+		data[k] = scalar * v
+	}
+	return &COOMatrix{rows: c.rows, cols: c.cols, rowIdx: c.rowIdx, colIdx: c.colIdx, data: data}, nil
+}
+
+func (c *COOMatrix) CompareDimensions(other Matrix) bool {
+	if other == nil {
+		return false
+	}
+
+	return c.Rows() == other.Rows() && c.Cols() == other.Cols()
+}
+
+func (c *COOMatrix) Mul(other Matrix) (Matrix, error) {
+	return c.compressed().Mul(other)
+}
+
+// Transpose returns the transpose in COO format, sharing storage with c.
+func (c *COOMatrix) Transpose() Matrix {
+	return &COOMatrix{rows: c.cols, cols: c.rows, rowIdx: c.colIdx, colIdx: c.rowIdx, data: c.data}
+}
+
+// compressed returns the matrix in CSR format, converting the triplets on the first call and
+// reusing the result afterwards. It is safe for concurrent use.
+func (c *COOMatrix) compressed() *CSRMatrix {
+	c.once.Do(func() {
+		c.csr = csrFromTriplets(c.rows, c.cols, c.rowIdx, c.colIdx, c.data)
+	})
+	return c.csr
+}
+
+// isSparse reports whether m is one of the sparse matrix types.
+func isSparse(m Matrix) bool {
+	switch m.(type) {
+	case *CSRMatrix, *CSCMatrix, *COOMatrix:
+		return true
+	}
+	return false
+}
+
+// csrFromTriplets builds a CSRMatrix from unordered triplets, summing repeated coordinates.
+func csrFromTriplets(rows, cols int, rowIdx, colIdx []int, values []float64) *CSRMatrix {
+	indptr := make([]int, rows+1)
+	for _, i := range rowIdx {
+		indptr[i+1]++
+	}
+	for i := 0; i < rows; i++ {
+		indptr[i+1] += indptr[i]
+	}
+
+	// Bucket the triplets by row.
+	indices := make([]int, len(values))
+	data := make([]float64, len(values))
+	next := append([]int(nil), indptr[:rows]...)
+	for k, i := range rowIdx {
+		indices[next[i]] = colIdx[k]
+		data[next[i]] = values[k]
+		next[i]++
+	}
+
+	// Sort every row by column and merge repeated columns, compacting in place.
+	nnz, start := 0, 0
+	for i := 0; i < rows; i++ {
+		end := indptr[i+1]
+		sort.Sort(sparseRow{indices: indices[start:end], data: data[start:end]})
+		for p := start; p < end; p++ {
+			if nnz > indptr[i] && indices[nnz-1] == indices[p] {
+				data[nnz-1] += data[p]
+				continue
+			}
+			indices[nnz], data[nnz] = indices[p], data[p]
+			nnz++
+		}
+		start = end
+		indptr[i+1] = nnz
+	}
+
+	return &CSRMatrix{rows: rows, cols: cols, indptr: indptr, indices: indices[:nnz], data: data[:nnz]}
+}
+
+// sparseRow sorts the elements of a sparse row by index.
+type sparseRow struct {
+	indices []int
+	data    []float64
+}
+
+func (r sparseRow) Len() int {
+	return len(r.indices)
+}
+
+func (r sparseRow) Less(i, j int) bool {
+	return r.indices[i] < r.indices[j]
+}
+
+func (r sparseRow) Swap(i, j int) {
+	r.indices[i], r.indices[j] = r.indices[j], r.indices[i]
+	r.data[i], r.data[j] = r.data[j], r.data[i]
+}
@@ -0,0 +1,409 @@
+package algebra
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// sparseFixtures returns the matrix
+//
+//	1 0 2
+//	0 0 3
+//	4 5 0
+//
+// in CSR, CSC and COO format, the latter with a repeated coordinate.
+func sparseFixtures(t *testing.T) []Matrix {
+	t.Helper()
+	csr, err := NewCSR(3, 3, []int{0, 2, 3, 5}, []int{0, 2, 2, 0, 1}, []float64{1, 2, 3, 4, 5})
+	if err != nil {
+		t.Fatalf("NewCSR() error = %v", err)
+	}
+	csc, err := NewCSC(3, 3, []int{0, 2, 3, 5}, []int{0, 2, 2, 0, 1}, []float64{1, 4, 5, 2, 3})
+	if err != nil {
+		t.Fatalf("NewCSC() error = %v", err)
+	}
+	coo, err := NewCOO(3, 3, []int{2, 0, 2, 0, 1, 2}, []int{1, 0, 0, 2, 2, 1}, []float64{2, 1, 4, 2, 3, 3})
+	if err != nil {
+		t.Fatalf("NewCOO() error = %v", err)
+	}
+	return []Matrix{csr, csc, coo}
+}
+
+var sparseFixtureDense = &FlatMatrix{data: []float64{1, 0, 2, 0, 0, 3, 4, 5, 0}, rows: 3, cols: 3}
+
+func randomSparse(r *rand.Rand, rows, cols int, density float64) *FlatMatrix {
+	m := &FlatMatrix{data: make([]float64, rows*cols), rows: rows, cols: cols}
+	for i := range m.data {
+		if r.Float64() < density {
+			m.data[i] = r.Float64()*2 - 1
+		}
+	}
+	return m
+}
+
+func TestNewCSR(t *testing.T) {
+	tests := []struct {
+		name    string
+		rows    int
+		cols    int
+		indptr  []int
+		indices []int
+		data    []float64
+		wantErr error
+	}{
+		{
+			name:    "Test valid CSR",
+			rows:    2,
+			cols:    3,
+			indptr:  []int{0, 1, 3},
+			indices: []int{2, 0, 1},
+			data:    []float64{1, 2, 3},
+		},
+		{
+			name:   "Test CSR without elements",
+			rows:   2,
+			cols:   2,
+			indptr: []int{0, 0, 0},
+		},
+		{
+			name:    "Test negative dimensions should return error",
+			rows:    -1,
+			cols:    2,
+			indptr:  []int{0},
+			wantErr: ErrInvalidDimensions,
+		},
+		{
+			name:    "Test wrong number of row pointers should return error",
+			rows:    2,
+			cols:    2,
+			indptr:  []int{0, 1},
+			indices: []int{0},
+			data:    []float64{1},
+			wantErr: ErrInvalidSparseStructure,
+		},
+		{
+			name:    "Test decreasing row pointers should return error",
+			rows:    2,
+			cols:    2,
+			indptr:  []int{0, 2, 1},
+			indices: []int{0},
+			data:    []float64{1},
+			wantErr: ErrInvalidSparseStructure,
+		},
+		{
+			name:    "Test unsorted column indices should return error",
+			rows:    1,
+			cols:    3,
+			indptr:  []int{0, 2},
+			indices: []int{2, 1},
+			data:    []float64{1, 2},
+			wantErr: ErrInvalidSparseStructure,
+		},
+		{
+			name:    "Test column index out of range should return error",
+			rows:    1,
+			cols:    2,
+			indptr:  []int{0, 1},
+			indices: []int{2},
+			data:    []float64{1},
+			wantErr: ErrInvalidSparseStructure,
+		},
+		{
+			name:    "Test mismatched values should return error",
+			rows:    1,
+			cols:    2,
+			indptr:  []int{0, 1},
+			indices: []int{0},
+			data:    []float64{1, 2},
+			wantErr: ErrInvalidSparseStructure,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCSR(tt.rows, tt.cols, tt.indptr, tt.indices, tt.data)
+			if err != tt.wantErr {
+				t.Fatalf("NewCSR() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.Rows() != tt.rows || got.Cols() != tt.cols || got.(*CSRMatrix).NNZ() != len(tt.data) {
+				t.Errorf("NewCSR() = %v", got)
+			}
+
+			if _, err := NewCSC(tt.cols, tt.rows, tt.indptr, tt.indices, tt.data); err != nil {
+				t.Errorf("NewCSC() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestNewCOO(t *testing.T) {
+	if _, err := NewCOO(2, 2, []int{0}, []int{0, 1}, []float64{1}); err != ErrInvalidDimensions {
+		t.Errorf("NewCOO() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+	if _, err := NewCOO(2, 2, []int{2}, []int{0}, []float64{1}); err != ErrorIndexOutOfBounds {
+		t.Errorf("NewCOO() error = %v, want %v", err, ErrorIndexOutOfBounds)
+	}
+
+	rowIdx := []int{1}
+	m, err := NewCOO(2, 2, rowIdx, []int{0}, []float64{1})
+	if err != nil {
+		t.Fatalf("NewCOO() error = %v", err)
+	}
+	rowIdx[0] = 0
+	if m.MustAt(1, 0) != 1 {
+		t.Errorf("NewCOO() does not copy its input")
+	}
+}
+
+func TestSparse_At(t *testing.T) {
+	for _, m := range sparseFixtures(t) {
+		t.Run(fmt.Sprintf("Test At of %T", m), func(t *testing.T) {
+			if !reflect.DeepEqual(mustFlat(t, m), sparseFixtureDense) {
+				t.Errorf("At() elements = %v, want %v", mustFlat(t, m), sparseFixtureDense)
+			}
+			if _, err := m.At(3, 0); err != ErrorIndexOutOfBounds {
+				t.Errorf("At() error = %v, want %v", err, ErrorIndexOutOfBounds)
+			}
+			if _, err := m.At(0, -1); err != ErrorIndexOutOfBounds {
+				t.Errorf("At() error = %v, want %v", err, ErrorIndexOutOfBounds)
+			}
+			if m.Empty() {
+				t.Errorf("Empty() = true, want false")
+			}
+		})
+	}
+}
+
+func TestCOOMatrix_AtConcurrent(t *testing.T) {
+	rowIdx, colIdx, data := []int{2, 0, 2, 0, 1, 2}, []int{1, 0, 0, 2, 2, 1}, []float64{2, 1, 4, 2, 3, 3}
+	m, err := NewCOO(3, 3, rowIdx, colIdx, data)
+	if err != nil {
+		t.Fatalf("NewCOO() error = %v", err)
+	}
+
+	// Every goroutine may be the first to call At, which builds the lookup structure.
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k, v := range sparseFixtureDense.data {
+				if got := m.MustAt(k/3, k%3); got != v {
+					t.Errorf("At(%d, %d) = %v, want %v", k/3, k%3, got, v)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	gotRows, gotCols, gotData := m.(*COOMatrix).Raw()
+	if !reflect.DeepEqual(gotRows, rowIdx) || !reflect.DeepEqual(gotCols, colIdx) || !reflect.DeepEqual(gotData, data) {
+		t.Errorf("Raw() = %v, %v, %v, want the triplets in insertion order", gotRows, gotCols, gotData)
+	}
+}
+
+func TestSparse_Empty(t *testing.T) {
+	for _, shape := range [][2]int{{0, 3}, {3, 0}, {0, 0}} {
+		rows, cols := shape[0], shape[1]
+		csr, err := NewCSR(rows, cols, make([]int, rows+1), nil, nil)
+		if err != nil {
+			t.Fatalf("NewCSR() error = %v", err)
+		}
+		csc, err := NewCSC(rows, cols, make([]int, cols+1), nil, nil)
+		if err != nil {
+			t.Fatalf("NewCSC() error = %v", err)
+		}
+		coo, err := NewCOO(rows, cols, nil, nil, nil)
+		if err != nil {
+			t.Fatalf("NewCOO() error = %v", err)
+		}
+
+		want := (&FlatMatrix{data: []float64{}, rows: rows, cols: cols}).Empty()
+		for _, m := range []Matrix{csr, csc, coo} {
+			if got := m.Empty(); got != want {
+				t.Errorf("%T.Empty() of a %dx%d matrix = %v, want %v like FlatMatrix", m, rows, cols, got, want)
+			}
+		}
+	}
+}
+
+// mustFlat reads all elements of m through At into a FlatMatrix.
+func mustFlat(t *testing.T, m Matrix) *FlatMatrix {
+	t.Helper()
+	result := &FlatMatrix{data: make([]float64, m.Rows()*m.Cols()), rows: m.Rows(), cols: m.Cols()}
+	for i := 0; i < m.Rows(); i++ {
+		for j := 0; j < m.Cols(); j++ {
+			v, err := m.At(i, j)
+			if err != nil {
+				t.Fatalf("At(%d, %d) error = %v", i, j, err)
+			}
+			result.data[i*m.Cols()+j] = v
+		}
+	}
+	return result
+}
+
+func TestSparse_Conversions(t *testing.T) {
+	fixtures := sparseFixtures(t)
+
+	csr, err := ToCSR(sparseFixtureDense)
+	if err != nil {
+		t.Fatalf("ToCSR() error = %v", err)
+	}
+	if !reflect.DeepEqual(csr, fixtures[0]) {
+		t.Errorf("ToCSR() = %v, want %v", csr, fixtures[0])
+	}
+
+	for _, m := range append(fixtures, Matrix(sparseFixtureDense)) {
+		t.Run(fmt.Sprintf("Test conversions of %T", m), func(t *testing.T) {
+			flat, err := ToFlat(m)
+			if err != nil {
+				t.Fatalf("ToFlat() error = %v", err)
+			}
+			if !reflect.DeepEqual(flat, sparseFixtureDense) {
+				t.Errorf("ToFlat() = %v, want %v", flat, sparseFixtureDense)
+			}
+
+			csr, _ := ToCSR(m)
+			if !reflect.DeepEqual(csr, fixtures[0]) {
+				t.Errorf("ToCSR() = %v, want %v", csr, fixtures[0])
+			}
+
+			csc, _ := ToCSC(m)
+			indptr, indices, data := csc.Raw()
+			if !reflect.DeepEqual(indptr, []int{0, 2, 3, 5}) || !reflect.DeepEqual(indices, []int{0, 2, 2, 0, 1}) ||
+				!reflect.DeepEqual(data, []float64{1, 4, 5, 2, 3}) {
+				t.Errorf("ToCSC().Raw() = %v, %v, %v", indptr, indices, data)
+			}
+
+			coo, _ := ToCOO(m)
+			if got := mustFlat(t, coo); !reflect.DeepEqual(got, sparseFixtureDense) {
+				t.Errorf("ToCOO() = %v, want %v", got, sparseFixtureDense)
+			}
+		})
+	}
+
+	for _, convert := range []func(Matrix) (Matrix, error){
+		func(m Matrix) (Matrix, error) { return ToCSR(m) },
+		func(m Matrix) (Matrix, error) { return ToCSC(m) },
+		func(m Matrix) (Matrix, error) { return ToCOO(m) },
+		func(m Matrix) (Matrix, error) { return ToFlat(m) },
+	} {
+		if _, err := convert(nil); err != ErrNilMatrix {
+			t.Errorf("conversion of nil error = %v, want %v", err, ErrNilMatrix)
+		}
+	}
+}
+
+func TestSparse_AddSub(t *testing.T) {
+	fixtures := sparseFixtures(t)
+	for _, m := range fixtures {
+		for _, other := range append(fixtures, Matrix(sparseFixtureDense)) {
+			t.Run(fmt.Sprintf("Test Add and Sub of %T and %T", m, other), func(t *testing.T) {
+				sum, err := m.Add(other)
+				if err != nil {
+					t.Fatalf("Add() error = %v", err)
+				}
+				want := &FlatMatrix{data: []float64{2, 0, 4, 0, 0, 6, 8, 10, 0}, rows: 3, cols: 3}
+				if got := mustFlat(t, sum); !reflect.DeepEqual(got, want) {
+					t.Errorf("Add() = %v, want %v", got, want)
+				}
+				if _, dense := sum.(*FlatMatrix); dense == isSparse(other) {
+					t.Errorf("Add() returned %T", sum)
+				}
+
+				diff, err := m.Sub(other)
+				if err != nil {
+					t.Fatalf("Sub() error = %v", err)
+				}
+				if got := mustFlat(t, diff); !reflect.DeepEqual(got, &FlatMatrix{data: make([]float64, 9), rows: 3, cols: 3}) {
+					t.Errorf("Sub() = %v, want zeros", got)
+				}
+				if s, ok := diff.(interface{ NNZ() int }); ok && s.NNZ() != 0 {
+					t.Errorf("Sub() stored %d elements, want 0", s.NNZ())
+				}
+			})
+		}
+
+		if _, err := m.Add(nil); err != ErrNilMatrix {
+			t.Errorf("Add() error = %v, want %v", err, ErrNilMatrix)
+		}
+		if _, err := m.Sub(&FlatMatrix{data: []float64{1}, rows: 1, cols: 1}); err != ErrInvalidDimensions {
+			t.Errorf("Sub() error = %v, want %v", err, ErrInvalidDimensions)
+		}
+	}
+}
+
+func TestSparse_Mul(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	a := randomSparse(r, 40, 30, 0.1)
+	b := randomSparse(r, 30, 50, 0.1)
+	want, _ := mulNaive(a, b)
+
+	formats := []func(Matrix) (Matrix, error){
+		func(m Matrix) (Matrix, error) { return ToCSR(m) },
+		func(m Matrix) (Matrix, error) { return ToCSC(m) },
+		func(m Matrix) (Matrix, error) { return ToCOO(m) },
+		func(m Matrix) (Matrix, error) { return m, nil },
+	}
+	for _, left := range formats[:3] {
+		for _, right := range formats {
+			x, _ := left(a)
+			y, _ := right(b)
+			t.Run(fmt.Sprintf("Test Mul of %T and %T", x, y), func(t *testing.T) {
+				got, err := x.Mul(y)
+				if err != nil {
+					t.Fatalf("Mul() error = %v", err)
+				}
+				if !approxEqualFlat(got, want, 1e-12) {
+					t.Errorf("Mul() differs from the dense product")
+				}
+			})
+		}
+	}
+
+	for _, m := range sparseFixtures(t) {
+		if _, err := m.Mul(nil); err != ErrNilMatrix {
+			t.Errorf("Mul() error = %v, want %v", err, ErrNilMatrix)
+		}
+		if _, err := m.Mul(&FlatMatrix{data: []float64{1, 2}, rows: 2, cols: 1}); err != ErrMulDimensions {
+			t.Errorf("Mul() error = %v, want %v", err, ErrMulDimensions)
+		}
+	}
+}
+
+func TestSparse_TransposeScalarMulVec(t *testing.T) {
+	for _, m := range sparseFixtures(t) {
+		t.Run(fmt.Sprintf("Test Transpose, ScalarMul and MulVec of %T", m), func(t *testing.T) {
+			want := &FlatMatrix{data: []float64{1, 0, 4, 0, 0, 5, 2, 3, 0}, rows: 3, cols: 3}
+			if got := mustFlat(t, m.Transpose()); !reflect.DeepEqual(got, want) {
+				t.Errorf("Transpose() = %v, want %v", got, want)
+			}
+
+			scaled, _ := m.ScalarMul(2)
+			want = &FlatMatrix{data: []float64{2, 0, 4, 0, 0, 6, 8, 10, 0}, rows: 3, cols: 3}
+			if got := mustFlat(t, scaled); !reflect.DeepEqual(got, want) {
+				t.Errorf("ScalarMul() = %v, want %v", got, want)
+			}
+
+			mv := m.(interface {
+				MulVec(x []float64) ([]float64, error)
+			})
+			got, err := mv.MulVec([]float64{1, 2, 3})
+			if err != nil {
+				t.Fatalf("MulVec() error = %v", err)
+			}
+			if want := []float64{7, 9, 14}; !reflect.DeepEqual(got, want) {
+				t.Errorf("MulVec() = %v, want %v", got, want)
+			}
+			if _, err := mv.MulVec([]float64{1}); err != ErrInvalidDimensions {
+				t.Errorf("MulVec() error = %v, want %v", err, ErrInvalidDimensions)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package algebra
+
+import "sync/atomic"
+
+// defaultStrassenCrossover is the block size below which StrassenMul switches to the
+// regular multiplication kernel.
+const defaultStrassenCrossover = 256
+
+// strassenCrossover is the configured crossover size. Zero selects defaultStrassenCrossover.
+var strassenCrossover atomic.Int64
+
+// SetStrassenCrossover sets the block size below which StrassenMul falls back to the regular
+// multiplication kernel. A value of zero or less restores the default.
+func SetStrassenCrossover(n int) {
+	strassenCrossover.Store(int64(max(n, 0)))
+}
+
+// StrassenCrossover returns the block size below which StrassenMul falls back to the regular
+// multiplication kernel.
+func StrassenCrossover() int {
+	if n := strassenCrossover.Load(); n > 0 {
+		return int(n)
+	}
+	return defaultStrassenCrossover
+}
+
+// StrassenMul returns the product a*b computed with the Winograd variant of Strassen's
+// algorithm, which needs 7 instead of 8 half-sized products per level of recursion.
+// It pays off for large matrices only: operands are split until their blocks are no larger
+// than StrassenCrossover, and smaller products use the regular kernel. Dimensions that do
+// not divide evenly are zero padded. The result differs from Mul by rounding errors only.
+func StrassenMul(a, b Matrix) (Matrix, error) {
+	if a == nil || b == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if a.Cols() != b.Rows() {
+		return nil, ErrMulDimensions
+	}
+
+	m, k, n := a.Rows(), a.Cols(), b.Cols()
+	crossover := StrassenCrossover()
+
+	levels := 0
+	for min(m, k, n)>>levels > crossover {
+		levels++
+	}
+	if levels == 0 {
+		return mul(a, b)
+	}
+
+	// Pad every dimension up to a multiple of 2^levels so that all splits are even.
+	pad := func(d int) int {
+		unit := 1 << levels
+		return (d + unit - 1) / unit * unit
+	}
+	pm, pk, pn := pad(m), pad(k), pad(n)
+
+	c := newBlock(pm, pn)
+	strassen(c, paddedBlock(a, pm, pk), paddedBlock(b, pk, pn), crossover)
+
+	result := make([]float64, m*n)
+	for i := 0; i < m; i++ {
+		copy(result[i*n:(i+1)*n], c.row(i))
+	}
+	return NewMatrixFlat(result, m, n)
+}
+
+// block is a row-major window over a float64 slice used by the Strassen recursion.
+type block struct {
+	data   []float64
+	stride int
+	rows   int
+	cols   int
+}
+
+func newBlock(rows, cols int) block {
+	return block{data: make([]float64, rows*cols), stride: cols, rows: rows, cols: cols}
+}
+
+// paddedBlock returns the elements of m in a rows x cols block, zero padded at the bottom
+// and right. The storage of m is reused when no padding or copy is needed.
+func paddedBlock(m Matrix, rows, cols int) block {
+	if data, stride, ok := strided(m); ok && rows == m.Rows() && cols == m.Cols() {
+		return block{data: data, stride: stride, rows: rows, cols: cols}
+	}
+
+	b := newBlock(rows, cols)
+	for i := 0; i < m.Rows(); i++ {
+		for j := 0; j < m.Cols(); j++ {
+			b.data[i*cols+j] = m.MustAt(i, j)
+		}
+	}
+	return b
+}
+
+func (b block) row(i int) []float64 {
+	return b.data[i*b.stride : i*b.stride+b.cols]
+}
+
+// quadrants splits b into its four equally sized quadrants.
+func (b block) quadrants() (b11, b12, b21, b22 block) {
+	r, c := b.rows/2, b.cols/2
+	sub := func(i, j int) block {
+		return block{data: b.data[i*b.stride+j:], stride: b.stride, rows: r, cols: c}
+	}
+	return sub(0, 0), sub(0, c), sub(r, 0), sub(r, c)
+}
+
+// combine stores x + sign*y into dst element-wise.
+func combine(dst, x, y block, sign float64) {
+	for i := 0; i < dst.rows; i++ {
+		scaledAdd(dst.row(i), x.row(i), sign, y.row(i))
+	}
+}
+
+// strassen stores the product a*b into c using the Winograd form of Strassen's algorithm.
+func strassen(c, a, b block, crossover int) {
+	if min(a.rows, a.cols, b.cols) <= crossover || a.rows%2 != 0 || a.cols%2 != 0 || b.cols%2 != 0 {
+		backend().Gemm(a.rows, b.cols, a.cols, 1, a.data, a.stride, b.data, b.stride, 0, c.data, c.stride)
+		return
+	}
+
+	a11, a12, a21, a22 := a.quadrants()
+	b11, b12, b21, b22 := b.quadrants()
+	c11, c12, c21, c22 := c.quadrants()
+	m, k, n := a11.rows, a11.cols, b11.cols
+
+	s1, s2 := newBlock(m, k), newBlock(m, k)
+	t1, t2 := newBlock(k, n), newBlock(k, n)
+	p := newBlock(m, n)
+
+	// S1 = A21 + A22, T1 = B12 - B11, P5 = S1*T1 goes into C12 and C22.
+	combine(s1, a21, a22, 1)
+	combine(t1, b12, b11, -1)
+	strassen(p, s1, t1, crossover)
+	copyBlock(c12, p)
+	copyBlock(c22, p)
+
+	// S2 = S1 - A11, T2 = B22 - T1, P6 = S2*T2.
+	combine(s2, s1, a11, -1)
+	combine(t2, b22, t1, -1)
+	strassen(c21, s2, t2, crossover)
+
+	// P1 = A11*B11, U2 = P1 + P6 is kept in C21.
+	strassen(p, a11, b11, crossover)
+	combine(c21, c21, p, 1)
+
+	// P2 = A12*B21, C11 = P1 + P2.
+	strassen(c11, a12, b21, crossover)
+	combine(c11, c11, p, 1)
+
+	// U4 = U2 + P5 goes into C12.
+	combine(c12, c12, c21, 1)
+
+	// S3 = A11 - A21, T3 = B22 - B12, P7 = S3*T3, U3 = U2 + P7 is kept in C21.
+	combine(s1, a11, a21, -1)
+	combine(t1, b22, b12, -1)
+	strassen(p, s1, t1, crossover)
+	combine(c21, c21, p, 1)
+
+	// C22 = U3 + P5.
+	combine(c22, c22, c21, 1)
+
+	// S4 = A12 - S2, P3 = S4*B22, C12 = U4 + P3.
+	combine(s1, a12, s2, -1)
+	strassen(p, s1, b22, crossover)
+	combine(c12, c12, p, 1)
+
+	// T4 = T2 - B21, P4 = A22*T4, C21 = U3 - P4.
+	combine(t1, t2, b21, -1)
+	strassen(p, a22, t1, crossover)
+	combine(c21, c21, p, -1)
+}
+
+// copyBlock copies the elements of src into dst.
+func copyBlock(dst, src block) {
+	for i := 0; i < dst.rows; i++ {
+		copy(dst.row(i), src.row(i))
+	}
+}
@@ -0,0 +1,107 @@
+package algebra
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestStrassenMul(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	tests := []struct {
+		rows      int
+		inner     int
+		cols      int
+		crossover int
+	}{
+		{rows: 2, inner: 2, cols: 2, crossover: 1},
+		{rows: 16, inner: 16, cols: 16, crossover: 4},
+		{rows: 33, inner: 17, cols: 25, crossover: 4},
+		{rows: 100, inner: 100, cols: 100, crossover: 16},
+		{rows: 64, inner: 200, cols: 48, crossover: 8},
+		{rows: 10, inner: 10, cols: 10, crossover: 256},
+	}
+	for _, tt := range tests {
+		name := fmt.Sprintf("Test %dx%d times %dx%d with crossover %d", tt.rows, tt.inner, tt.inner, tt.cols, tt.crossover)
+		t.Run(name, func(t *testing.T) {
+			SetStrassenCrossover(tt.crossover)
+			defer SetStrassenCrossover(0)
+
+			a := randomMatrix(r, tt.rows, tt.inner)
+			b := randomMatrix(r, tt.inner, tt.cols)
+			got, err := StrassenMul(a, b)
+			if err != nil {
+				t.Fatalf("StrassenMul() error = %v", err)
+			}
+			want, _ := mulNaive(a, b)
+			if !approxEqualFlat(got, want, 1e-9) {
+				t.Errorf("StrassenMul() differs from the naive product")
+			}
+		})
+	}
+}
+
+func TestStrassenMul_Views(t *testing.T) {
+	SetStrassenCrossover(4)
+	defer SetStrassenCrossover(0)
+
+	r := rand.New(rand.NewSource(4))
+	a := randomMatrix(r, 40, 40)
+	av, _ := a.View(3, 5, 32, 32)
+	bv, _ := a.View(8, 1, 32, 24)
+
+	got, err := StrassenMul(av, bv)
+	if err != nil {
+		t.Fatalf("StrassenMul() error = %v", err)
+	}
+	want, _ := mulNaive(av, bv)
+	if !approxEqualFlat(got, want, 1e-9) {
+		t.Errorf("StrassenMul() of views differs from the naive product")
+	}
+}
+
+func TestStrassenMul_Errors(t *testing.T) {
+	a := &FlatMatrix{data: []float64{1, 2, 3, 4}, rows: 2, cols: 2}
+	b := &FlatMatrix{data: []float64{1, 2, 3}, rows: 1, cols: 3}
+
+	if _, err := StrassenMul(a, b); err != ErrMulDimensions {
+		t.Errorf("StrassenMul() error = %v, want %v", err, ErrMulDimensions)
+	}
+	if _, err := StrassenMul(nil, a); err != ErrNilMatrix {
+		t.Errorf("StrassenMul() error = %v, want %v", err, ErrNilMatrix)
+	}
+	if _, err := StrassenMul(a, nil); err != ErrNilMatrix {
+		t.Errorf("StrassenMul() error = %v, want %v", err, ErrNilMatrix)
+	}
+}
+
+func TestStrassenCrossover(t *testing.T) {
+	defer SetStrassenCrossover(0)
+
+	if got := StrassenCrossover(); got != defaultStrassenCrossover {
+		t.Errorf("StrassenCrossover() = %v, want %v", got, defaultStrassenCrossover)
+	}
+	SetStrassenCrossover(64)
+	if got := StrassenCrossover(); got != 64 {
+		t.Errorf("StrassenCrossover() = %v, want %v", got, 64)
+	}
+}
+
+func BenchmarkStrassenMul(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1024, 2048} {
+		x := randomMatrix(r, n, n)
+		y := randomMatrix(r, n, n)
+
+		b.Run(fmt.Sprintf("blocked-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = x.Mul(y)
+			}
+		})
+		b.Run(fmt.Sprintf("strassen-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = StrassenMul(x, y)
+			}
+		})
+	}
+}
@@ -0,0 +1,723 @@
+package algebra
+
+// DiagonalMatrix is a square matrix whose elements off the main diagonal are all zero.
+// Only the diagonal is stored. Like the other structured matrices it is immutable.
+type DiagonalMatrix struct {
+	diag []float64
+}
+
+// TriangularMatrix is a square upper or lower triangular matrix. Only the triangle is stored,
+// packed row by row: n(n+1)/2 elements instead of n².
+type TriangularMatrix struct {
+	n     int
+	upper bool
+	data  []float64
+}
+
+// SymmetricMatrix is a square matrix equal to its transpose. Only the upper triangle is
+// stored, packed row by row like an upper TriangularMatrix.
+type SymmetricMatrix struct {
+	n    int
+	data []float64
+}
+
+// BandedMatrix is a matrix whose nonzero elements all lie within kl diagonals below and ku
+// diagonals above the main diagonal. Row i stores the kl+ku+1 elements of columns i-kl through
+// i+ku, so the element at row i and column j is data[i*(kl+ku+1)+j-i+kl]. This is the
+// row-major band layout of CBLAS, not the column-major LAPACK layout ab[ku+i-j][j]; LAPACK band
+// data describes the transpose. Positions of the band that fall outside the matrix are ignored.
+type BandedMatrix struct {
+	rows int
+	cols int
+	kl   int
+	ku   int
+	data []float64
+}
+
+// NewDiagonal returns the square matrix with the given diagonal, which is copied.
+func NewDiagonal(diag []float64) (Matrix, error) {
+	return &DiagonalMatrix{diag: append([]float64(nil), diag...)}, nil
+}
+
+// NewTriangular returns the n x n upper or lower triangular matrix whose triangle is packed
+// row by row in data, which is copied and must hold n(n+1)/2 elements.
+func NewTriangular(n int, upper bool, data []float64) (Matrix, error) {
+	if n < 0 || len(data) != n*(n+1)/2 {
+		return nil, ErrInvalidDimensions
+	}
+	return &TriangularMatrix{n: n, upper: upper, data: append([]float64(nil), data...)}, nil
+}
+
+// NewSymmetric returns the n x n symmetric matrix whose upper triangle is packed row by row in
+// data, which is copied and must hold n(n+1)/2 elements.
+func NewSymmetric(n int, data []float64) (Matrix, error) {
+	if n < 0 || len(data) != n*(n+1)/2 {
+		return nil, ErrInvalidDimensions
+	}
+	return &SymmetricMatrix{n: n, data: append([]float64(nil), data...)}, nil
+}
+
+// NewBanded returns the rows x cols matrix with kl subdiagonals and ku superdiagonals stored
+// in row-major band format in data, which is copied and must hold rows*(kl+ku+1) elements.
+func NewBanded(rows, cols, kl, ku int, data []float64) (Matrix, error) {
+	if rows < 0 || cols < 0 || kl < 0 || ku < 0 || len(data) != rows*(kl+ku+1) {
+		return nil, ErrInvalidDimensions
+	}
+	return &BandedMatrix{rows: rows, cols: cols, kl: kl, ku: ku, data: append([]float64(nil), data...)}, nil
+}
+
+// Diagonal returns a copy of the diagonal.
+func (d *DiagonalMatrix) Diagonal() []float64 {
+	return append([]float64(nil), d.diag...)
+}
+
+// MulVec returns the product of the matrix and the column vector x in O(n).
+func (d *DiagonalMatrix) MulVec(x []float64) ([]float64, error) {
+	if len(x) != len(d.diag) {
+		return nil, ErrInvalidDimensions
+	}
+
+	result := make([]float64, len(x))
+	for i, v := range d.diag {
+		result[i] = v * x[i]
+	}
+	return result, nil
+}
+
+// Solve returns the x solving D*x = b in O(n), or ErrSingularMatrix if the diagonal holds a zero.
+func (d *DiagonalMatrix) Solve(b []float64) ([]float64, error) {
+	if len(b) != len(d.diag) {
+		return nil, ErrInvalidDimensions
+	}
+
+	x := make([]float64, len(b))
+	for i, v := range d.diag {
+		if v == 0 {
+			return nil, ErrSingularMatrix
+		}
+		x[i] = b[i] / v
+	}
+	return x, nil
+}
+
+func (d *DiagonalMatrix) Rows() int {
+	return len(d.diag)
+}
+
+func (d *DiagonalMatrix) Cols() int {
+	return len(d.diag)
+}
+
+func (d *DiagonalMatrix) At(i, j int) (float64, error) {
+	if i < 0 || i >= len(d.diag) || j < 0 || j >= len(d.diag) {
+		return 0, ErrorIndexOutOfBounds
+	}
+
+	if i != j {
+		return 0, nil
+	}
+	return d.diag[i], nil
+}
+
+func (d *DiagonalMatrix) MustAt(i, j int) (v float64) {
+	var err error
+	if v, err = d.At(i, j); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func (d *DiagonalMatrix) Empty() bool {
+	return len(d.diag) == 0
+}
+
+func (d *DiagonalMatrix) Add(other Matrix) (Matrix, error) {
+	return d.addScaled(other, 1)
+}
+
+func (d *DiagonalMatrix) Sub(other Matrix) (Matrix, error) {
+	return d.addScaled(other, -1)
+}
+
+func (d *DiagonalMatrix) ScalarMul(scalar float64) (Matrix, error) {
+	return &DiagonalMatrix{diag: scaledCopy(scalar, d.diag)}, nil
+}
+
+func (d *DiagonalMatrix) CompareDimensions(other Matrix) bool {
+	if other == nil {
+		return false
+	}
+
+	return d.Rows() == other.Rows() && d.Cols() == other.Cols()
+}
+
+// Mul returns the product of the matrix and other. Scaling the rows of other costs
+// O(n*other.Cols()), and the product of two diagonal matrices is diagonal.
+func (d *DiagonalMatrix) Mul(other Matrix) (Matrix, error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if len(d.diag) != other.Rows() {
+		return nil, ErrMulDimensions
+	}
+
+	if o, ok := other.(*DiagonalMatrix); ok {
+		diag := make([]float64, len(d.diag))
+		for i, v := range d.diag {
+			diag[i] = v * o.diag[i]
+		}
+		return &DiagonalMatrix{diag: diag}, nil
+	}
+
+	return supportMul(d, other, func(i int) (int, int) {
+		return i, i + 1
+	})
+}
+
+// Transpose returns the matrix itself, which is its own transpose.
+func (d *DiagonalMatrix) Transpose() Matrix {
+	return d
+}
+
+// addScaled returns d + alpha*other, diagonal when other is diagonal and a FlatMatrix otherwise.
+func (d *DiagonalMatrix) addScaled(other Matrix, alpha float64) (Matrix, error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if !d.CompareDimensions(other) {
+		return nil, ErrInvalidDimensions
+	}
+
+	if o, ok := other.(*DiagonalMatrix); ok {
+		diag := append([]float64(nil), d.diag...)
+		backend().Axpy(alpha, o.diag, diag)
+		return &DiagonalMatrix{diag: diag}, nil
+	}
+
+	result, _ := ToFlat(other)
+	backend().Scal(alpha, result.data)
+	for i, v := range d.diag {
+		result.data[i*len(d.diag)+i] += v
+	}
+	return result, nil
+}
+
+// Upper reports whether the matrix is upper (rather than lower) triangular.
+func (t *TriangularMatrix) Upper() bool {
+	return t.upper
+}
+
+// MulVec returns the product of the matrix and the column vector x, skipping the zero triangle.
+func (t *TriangularMatrix) MulVec(x []float64) ([]float64, error) {
+	return supportMulVec(t, x, t.support)
+}
+
+// Solve returns the x solving T*x = b by forward or back substitution in O(n²), or
+// ErrSingularMatrix if the diagonal holds a zero.
+func (t *TriangularMatrix) Solve(b []float64) ([]float64, error) {
+	if len(b) != t.n {
+		return nil, ErrInvalidDimensions
+	}
+
+	x := append([]float64(nil), b...)
+	solve := func(i int) error {
+		lo, hi := t.support(i)
+		for j := lo; j < hi; j++ {
+			if j != i {
+				x[i] -= t.data[t.index(i, j)] * x[j]
+			}
+		}
+
+		d := t.data[t.index(i, i)]
+		if d == 0 {
+			return ErrSingularMatrix
+		}
+		x[i] /= d
+		return nil
+	}
+
+	if t.upper {
+		for i := t.n - 1; i >= 0; i-- {
+			if err := solve(i); err != nil {
+				return nil, err
+			}
+		}
+		return x, nil
+	}
+
+	for i := 0; i < t.n; i++ {
+		if err := solve(i); err != nil {
+			return nil, err
+		}
+	}
+	return x, nil
+}
+
+func (t *TriangularMatrix) Rows() int {
+	return t.n
+}
+
+func (t *TriangularMatrix) Cols() int {
+	return t.n
+}
+
+func (t *TriangularMatrix) At(i, j int) (float64, error) {
+	if i < 0 || i >= t.n || j < 0 || j >= t.n {
+		return 0, ErrorIndexOutOfBounds
+	}
+
+	if (t.upper && j < i) || (!t.upper && j > i) {
+		return 0, nil
+	}
+	return t.data[t.index(i, j)], nil
+}
+
+func (t *TriangularMatrix) MustAt(i, j int) (v float64) {
+	var err error
+	if v, err = t.At(i, j); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func (t *TriangularMatrix) Empty() bool {
+	return t.n == 0
+}
+
+func (t *TriangularMatrix) Add(other Matrix) (Matrix, error) {
+	return t.addScaled(other, 1)
+}
+
+func (t *TriangularMatrix) Sub(other Matrix) (Matrix, error) {
+	return t.addScaled(other, -1)
+}
+
+func (t *TriangularMatrix) ScalarMul(scalar float64) (Matrix, error) {
+	return &TriangularMatrix{n: t.n, upper: t.upper, data: scaledCopy(scalar, t.data)}, nil
+}
+
+func (t *TriangularMatrix) CompareDimensions(other Matrix) bool {
+	if other == nil {
+		return false
+	}
+
+	return t.Rows() == other.Rows() && t.Cols() == other.Cols()
+}
+
+// Mul returns the product of the matrix and other, skipping the zero triangle. The product of
+// two upper (or two lower) triangular matrices is triangular and costs a sixth of a dense one.
+func (t *TriangularMatrix) Mul(other Matrix) (Matrix, error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if t.n != other.Rows() {
+		return nil, ErrMulDimensions
+	}
+
+	if o, ok := other.(*TriangularMatrix); ok && o.upper == t.upper {
+		result := &TriangularMatrix{n: t.n, upper: t.upper, data: make([]float64, len(t.data))}
+		for i := 0; i < t.n; i++ {
+			lo, hi := t.support(i)
+			for j := lo; j < hi; j++ {
+				// The inner index runs between i and j for both orientations.
+				var sum float64
+				for k := min(i, j); k <= max(i, j); k++ {
+					sum += t.data[t.index(i, k)] * o.data[o.index(k, j)]
+				}
+				result.data[t.index(i, j)] = sum
+			}
+		}
+		return result, nil
+	}
+
+	return supportMul(t, other, t.support)
+}
+
+// Transpose returns the transpose, which is triangular with the opposite orientation.
+func (t *TriangularMatrix) Transpose() Matrix {
+	result := &TriangularMatrix{n: t.n, upper: !t.upper, data: make([]float64, len(t.data))}
+	for i := 0; i < t.n; i++ {
+		lo, hi := t.support(i)
+		for j := lo; j < hi; j++ {
+			result.data[result.index(j, i)] = t.data[t.index(i, j)]
+		}
+	}
+	return result
+}
+
+// addScaled returns t + alpha*other, triangular when other is triangular with the same
+// orientation and a FlatMatrix otherwise.
+func (t *TriangularMatrix) addScaled(other Matrix, alpha float64) (Matrix, error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if !t.CompareDimensions(other) {
+		return nil, ErrInvalidDimensions
+	}
+
+	if o, ok := other.(*TriangularMatrix); ok && o.upper == t.upper {
+		data := append([]float64(nil), t.data...)
+		backend().Axpy(alpha, o.data, data)
+		return &TriangularMatrix{n: t.n, upper: t.upper, data: data}, nil
+	}
+
+	result, _ := ToFlat(other)
+	backend().Scal(alpha, result.data)
+	for i := 0; i < t.n; i++ {
+		lo, hi := t.support(i)
+		for j := lo; j < hi; j++ {
+			result.data[i*t.n+j] += t.data[t.index(i, j)]
+		}
+	}
+	return result, nil
+}
+
+// support returns the range of columns of row i inside the triangle.
+func (t *TriangularMatrix) support(i int) (int, int) {
+	if t.upper {
+		return i, t.n
+	}
+	return 0, i + 1
+}
+
+// index returns the position in data of the element at row i and column j of the triangle.
+func (t *TriangularMatrix) index(i, j int) int {
+	if t.upper {
+		return packedUpper(t.n, i, j)
+	}
+	return i*(i+1)/2 + j
+}
+
+// MulVec returns the product of the matrix and the column vector x.
+func (s *SymmetricMatrix) MulVec(x []float64) ([]float64, error) {
+	if len(x) != s.n {
+		return nil, ErrInvalidDimensions
+	}
+
+	// Every stored element (i, j) above the diagonal contributes to rows i and j.
+	result := make([]float64, s.n)
+	for i := 0; i < s.n; i++ {
+		row := s.data[packedUpper(s.n, i, i) : packedUpper(s.n, i, s.n-1)+1]
+		result[i] += row[0] * x[i]
+		for k, v := range row[1:] {
+			j := i + 1 + k
+			result[i] += v * x[j]
+			result[j] += v * x[i]
+		}
+	}
+	return result, nil
+}
+
+func (s *SymmetricMatrix) Rows() int {
+	return s.n
+}
+
+func (s *SymmetricMatrix) Cols() int {
+	return s.n
+}
+
+func (s *SymmetricMatrix) At(i, j int) (float64, error) {
+	if i < 0 || i >= s.n || j < 0 || j >= s.n {
+		return 0, ErrorIndexOutOfBounds
+	}
+
+	if j < i {
+		i, j = j, i
+	}
+	return s.data[packedUpper(s.n, i, j)], nil
+}
+
+func (s *SymmetricMatrix) MustAt(i, j int) (v float64) {
+	var err error
+	if v, err = s.At(i, j); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func (s *SymmetricMatrix) Empty() bool {
+	return s.n == 0
+}
+
+func (s *SymmetricMatrix) Add(other Matrix) (Matrix, error) {
+	return s.addScaled(other, 1)
+}
+
+func (s *SymmetricMatrix) Sub(other Matrix) (Matrix, error) {
+	return s.addScaled(other, -1)
+}
+
+func (s *SymmetricMatrix) ScalarMul(scalar float64) (Matrix, error) {
+	return &SymmetricMatrix{n: s.n, data: scaledCopy(scalar, s.data)}, nil
+}
+
+func (s *SymmetricMatrix) CompareDimensions(other Matrix) bool {
+	if other == nil {
+		return false
+	}
+
+	return s.Rows() == other.Rows() && s.Cols() == other.Cols()
+}
+
+// Mul returns the product of the matrix and other as a FlatMatrix, since products of
+// symmetric matrices are not symmetric in general. The packed storage is unpacked first.
+func (s *SymmetricMatrix) Mul(other Matrix) (Matrix, error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if s.n != other.Rows() {
+		return nil, ErrMulDimensions
+	}
+
+	full, _ := ToFlat(s)
+	return mul(full, other)
+}
+
+// Transpose returns the matrix itself, which is its own transpose.
+func (s *SymmetricMatrix) Transpose() Matrix {
+	return s
+}
+
+// addScaled returns s + alpha*other, symmetric when other is symmetric or diagonal and a
+// FlatMatrix otherwise.
+func (s *SymmetricMatrix) addScaled(other Matrix, alpha float64) (Matrix, error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if !s.CompareDimensions(other) {
+		return nil, ErrInvalidDimensions
+	}
+
+	switch o := other.(type) {
+	case *SymmetricMatrix:
+		data := append([]float64(nil), s.data...)
+		backend().Axpy(alpha, o.data, data)
+		return &SymmetricMatrix{n: s.n, data: data}, nil
+	case *DiagonalMatrix:
+		data := append([]float64(nil), s.data...)
+		for i, v := range o.diag {
+			data[packedUpper(s.n, i, i)] += alpha * v
+		}
+		return &SymmetricMatrix{n: s.n, data: data}, nil
+	}
+
+	return addScaled(s, other, alpha)
+}
+
+// MulVec returns the product of the matrix and the column vector x in O(rows*(kl+ku)).
+func (b *BandedMatrix) MulVec(x []float64) ([]float64, error) {
+	return supportMulVec(b, x, b.support)
+}
+
+// Bandwidth returns the number of subdiagonals and superdiagonals of the band.
+func (b *BandedMatrix) Bandwidth() (kl, ku int) {
+	return b.kl, b.ku
+}
+
+func (b *BandedMatrix) Rows() int {
+	return b.rows
+}
+
+func (b *BandedMatrix) Cols() int {
+	return b.cols
+}
+
+func (b *BandedMatrix) At(i, j int) (float64, error) {
+	if i < 0 || i >= b.rows || j < 0 || j >= b.cols {
+		return 0, ErrorIndexOutOfBounds
+	}
+
+	if j < i-b.kl || j > i+b.ku {
+		return 0, nil
+	}
+	return b.data[b.index(i, j)], nil
+}
+
+func (b *BandedMatrix) MustAt(i, j int) (v float64) {
+	var err error
+	if v, err = b.At(i, j); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func (b *BandedMatrix) Empty() bool {
+	return b.rows == 0
+}
+
+func (b *BandedMatrix) Add(other Matrix) (Matrix, error) {
+	return b.addScaled(other, 1)
+}
+
+func (b *BandedMatrix) Sub(other Matrix) (Matrix, error) {
+	return b.addScaled(other, -1)
+}
+
+func (b *BandedMatrix) ScalarMul(scalar float64) (Matrix, error) {
+	return &BandedMatrix{rows: b.rows, cols: b.cols, kl: b.kl, ku: b.ku, data: scaledCopy(scalar, b.data)}, nil
+}
+
+func (b *BandedMatrix) CompareDimensions(other Matrix) bool {
+	if other == nil {
+		return false
+	}
+
+	return b.Rows() == other.Rows() && b.Cols() == other.Cols()
+}
+
+// Mul returns the product of the matrix and other, skipping elements outside the band.
+// The product of two banded matrices is banded, with the bandwidths added up.
+func (b *BandedMatrix) Mul(other Matrix) (Matrix, error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if b.cols != other.Rows() {
+		return nil, ErrMulDimensions
+	}
+
+	if o, ok := other.(*BandedMatrix); ok {
+		result := newBanded(b.rows, o.cols, b.kl+o.kl, b.ku+o.ku)
+		for i := 0; i < b.rows; i++ {
+			lo, hi := b.support(i)
+			for k := lo; k < hi; k++ {
+				v := b.data[b.index(i, k)]
+				oLo, oHi := o.support(k)
+				for j := oLo; j < oHi; j++ {
+					result.data[result.index(i, j)] += v * o.data[o.index(k, j)]
+				}
+			}
+		}
+		return result, nil
+	}
+
+	return supportMul(b, other, b.support)
+}
+
+// Transpose returns the transpose, which is banded with the bandwidths swapped.
+func (b *BandedMatrix) Transpose() Matrix {
+	result := newBanded(b.cols, b.rows, b.ku, b.kl)
+	for i := 0; i < b.rows; i++ {
+		lo, hi := b.support(i)
+		for j := lo; j < hi; j++ {
+			result.data[result.index(j, i)] = b.data[b.index(i, j)]
+		}
+	}
+	return result
+}
+
+// addScaled returns b + alpha*other, banded when other is banded and a FlatMatrix otherwise.
+func (b *BandedMatrix) addScaled(other Matrix, alpha float64) (Matrix, error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if !b.CompareDimensions(other) {
+		return nil, ErrInvalidDimensions
+	}
+
+	if o, ok := other.(*BandedMatrix); ok {
+		// The support of a row is contiguous in every band layout, so rows are added with AXPY.
+		result := newBanded(b.rows, b.cols, max(b.kl, o.kl), max(b.ku, o.ku))
+		be := backend()
+		for i := 0; i < b.rows; i++ {
+			if lo, hi := b.support(i); lo < hi {
+				be.Axpy(1, b.data[b.index(i, lo):b.index(i, hi)], result.data[result.index(i, lo):])
+			}
+			if lo, hi := o.support(i); lo < hi {
+				be.Axpy(alpha, o.data[o.index(i, lo):o.index(i, hi)], result.data[result.index(i, lo):])
+			}
+		}
+		return result, nil
+	}
+
+	result, _ := ToFlat(other)
+	backend().Scal(alpha, result.data)
+	for i := 0; i < b.rows; i++ {
+		lo, hi := b.support(i)
+		for j := lo; j < hi; j++ {
+			result.data[i*b.cols+j] += b.data[b.index(i, j)]
+		}
+	}
+	return result, nil
+}
+
+// support returns the range of columns of row i inside both the band and the matrix.
+func (b *BandedMatrix) support(i int) (int, int) {
+	return max(i-b.kl, 0), min(i+b.ku+1, b.cols)
+}
+
+// index returns the position in data of the element at row i and column j of the band.
+func (b *BandedMatrix) index(i, j int) int {
+	return i*(b.kl+b.ku+1) + j - i + b.kl
+}
+
+// newBanded returns a zero rows x cols banded matrix, with bandwidths capped to what fits
+// in the matrix.
+func newBanded(rows, cols, kl, ku int) *BandedMatrix {
+	kl, ku = min(kl, max(rows-1, 0)), min(ku, max(cols-1, 0))
+	return &BandedMatrix{rows: rows, cols: cols, kl: kl, ku: ku, data: make([]float64, rows*(kl+ku+1))}
+}
+
+// packedUpper returns the position of the element at row i and column j >= i in the upper
+// triangle of an n x n matrix packed row by row.
+func packedUpper(n, i, j int) int {
+	return i*(2*n-i+1)/2 + j - i
+}
+
+// scaledCopy returns alpha*x as a new slice.
+func scaledCopy(alpha float64, x []float64) []float64 {
+	result := make([]float64, len(x))
+	backend().Axpy(alpha, x, result)
+	return result
+}
+
+// supportMul returns a*b as a new FlatMatrix, where the nonzero elements of row i of a all lie
+// in the columns returned by support(i). Rows of b are accumulated with AXPY, so the cost is
+// proportional to the number of elements in the support of a times b.Cols(). Zeros stored
+// inside the support are not skipped, so 0*Inf and 0*NaN there give NaN like FlatMatrix.Mul;
+// elements outside the support are structurally zero and never read.
+func supportMul(a, b Matrix, support func(i int) (int, int)) (Matrix, error) {
+	rows, n := a.Rows(), b.Cols()
+	result := make([]float64, rows*n)
+
+	bd, ldb, ok := strided(b)
+	if !ok {
+		flat, _ := ToFlat(b)
+		bd, ldb = flat.data, n
+	}
+
+	be := backend()
+	for i := 0; i < rows; i++ {
+		lo, hi := support(i)
+		for k := lo; k < hi; k++ {
+			be.Axpy(a.MustAt(i, k), bd[k*ldb:k*ldb+n], result[i*n:(i+1)*n])
+		}
+	}
+	return NewMatrixFlat(result, rows, n)
+}
+
+// supportMulVec returns a*x, where the nonzero elements of row i of a all lie in the columns
+// returned by support(i).
+func supportMulVec(a Matrix, x []float64, support func(i int) (int, int)) ([]float64, error) {
+	if len(x) != a.Cols() {
+		return nil, ErrInvalidDimensions
+	}
+
+	result := make([]float64, a.Rows())
+	for i := range result {
+		lo, hi := support(i)
+		var sum float64
+		for j := lo; j < hi; j++ {
+			sum += a.MustAt(i, j) * x[j]
+		}
+		result[i] = sum
+	}
+	return result, nil
+}
@@ -0,0 +1,411 @@
+package algebra
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// structuredFixtures returns 3x3 structured matrices together with their dense equivalents.
+func structuredFixtures(t *testing.T) []struct {
+	m     Matrix
+	dense *FlatMatrix
+} {
+	t.Helper()
+	must := func(m Matrix, err error) Matrix {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("constructor error = %v", err)
+		}
+		return m
+	}
+	dense := func(data ...float64) *FlatMatrix {
+		return &FlatMatrix{data: data, rows: 3, cols: 3}
+	}
+
+	return []struct {
+		m     Matrix
+		dense *FlatMatrix
+	}{
+		{
+			m:     must(NewDiagonal([]float64{1, 2, 3})),
+			dense: dense(1, 0, 0, 0, 2, 0, 0, 0, 3),
+		},
+		{
+			m:     must(NewTriangular(3, true, []float64{1, 2, 3, 4, 5, 6})),
+			dense: dense(1, 2, 3, 0, 4, 5, 0, 0, 6),
+		},
+		{
+			m:     must(NewTriangular(3, false, []float64{1, 2, 3, 4, 5, 6})),
+			dense: dense(1, 0, 0, 2, 3, 0, 4, 5, 6),
+		},
+		{
+			m:     must(NewSymmetric(3, []float64{1, 2, 3, 4, 5, 6})),
+			dense: dense(1, 2, 3, 2, 4, 5, 3, 5, 6),
+		},
+		{
+			// The first and last elements fall outside the matrix and are ignored.
+			m:     must(NewBanded(3, 3, 1, 1, []float64{9, 1, 2, 3, 4, 5, 6, 7, 9})),
+			dense: dense(1, 2, 0, 3, 4, 5, 0, 6, 7),
+		},
+	}
+}
+
+func TestStructured_Constructors(t *testing.T) {
+	tests := []struct {
+		name string
+		new  func() (Matrix, error)
+	}{
+		{name: "Test triangular with wrong packed length", new: func() (Matrix, error) { return NewTriangular(3, true, make([]float64, 5)) }},
+		{name: "Test triangular with negative size", new: func() (Matrix, error) { return NewTriangular(-1, true, nil) }},
+		{name: "Test symmetric with wrong packed length", new: func() (Matrix, error) { return NewSymmetric(2, make([]float64, 4)) }},
+		{name: "Test banded with wrong data length", new: func() (Matrix, error) { return NewBanded(3, 3, 1, 0, make([]float64, 3)) }},
+		{name: "Test banded with negative bandwidth", new: func() (Matrix, error) { return NewBanded(3, 3, -1, 0, nil) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" should return error", func(t *testing.T) {
+			if _, err := tt.new(); err != ErrInvalidDimensions {
+				t.Errorf("constructor error = %v, want %v", err, ErrInvalidDimensions)
+			}
+		})
+	}
+
+	diag := []float64{1, 2}
+	d, _ := NewDiagonal(diag)
+	diag[0] = 5
+	if d.MustAt(0, 0) != 1 {
+		t.Errorf("NewDiagonal() does not copy its input")
+	}
+}
+
+func TestStructured_At(t *testing.T) {
+	for _, f := range structuredFixtures(t) {
+		t.Run(fmt.Sprintf("Test At of %T", f.m), func(t *testing.T) {
+			if got := mustFlat(t, f.m); !reflect.DeepEqual(got, f.dense) {
+				t.Errorf("At() elements = %v, want %v", got, f.dense)
+			}
+			if _, err := f.m.At(0, 3); err != ErrorIndexOutOfBounds {
+				t.Errorf("At() error = %v, want %v", err, ErrorIndexOutOfBounds)
+			}
+			if f.m.Empty() {
+				t.Errorf("Empty() = true, want false")
+			}
+		})
+	}
+}
+
+func TestStructured_Operations(t *testing.T) {
+	fixtures := structuredFixtures(t)
+	for _, f := range fixtures {
+		for _, g := range append(fixtures, struct {
+			m     Matrix
+			dense *FlatMatrix
+		}{m: sparseFixtureDense, dense: sparseFixtureDense}) {
+			t.Run(fmt.Sprintf("Test operations of %T and %T", f.m, g.m), func(t *testing.T) {
+				wantSum, _ := f.dense.Add(g.dense)
+				sum, err := f.m.Add(g.m)
+				if err != nil {
+					t.Fatalf("Add() error = %v", err)
+				}
+				if got := mustFlat(t, sum); !reflect.DeepEqual(got, wantSum) {
+					t.Errorf("Add() = %v, want %v", got, wantSum)
+				}
+
+				wantDiff, _ := f.dense.Sub(g.dense)
+				diff, err := f.m.Sub(g.m)
+				if err != nil {
+					t.Fatalf("Sub() error = %v", err)
+				}
+				if got := mustFlat(t, diff); !reflect.DeepEqual(got, wantDiff) {
+					t.Errorf("Sub() = %v, want %v", got, wantDiff)
+				}
+
+				wantProduct, _ := f.dense.Mul(g.dense)
+				product, err := f.m.Mul(g.m)
+				if err != nil {
+					t.Fatalf("Mul() error = %v", err)
+				}
+				if got := mustFlat(t, product); !reflect.DeepEqual(got, wantProduct) {
+					t.Errorf("Mul() = %v, want %v", got, wantProduct)
+				}
+			})
+		}
+	}
+}
+
+func TestStructured_Backend(t *testing.T) {
+	if err := RegisterBackend("counting", counting); err != nil && err != ErrBackendExists {
+		t.Fatalf("RegisterBackend() error = %v", err)
+	}
+	if err := SetBackend("counting"); err != nil {
+		t.Fatalf("SetBackend() error = %v", err)
+	}
+	defer SetBackend(DefaultBackend)
+
+	for _, f := range structuredFixtures(t) {
+		ops := map[string]func() (Matrix, error){
+			"Sub of the same structure": func() (Matrix, error) { return f.m.Sub(f.m) },
+			"ScalarMul":                 func() (Matrix, error) { return f.m.ScalarMul(2) },
+		}
+		for name, op := range ops {
+			t.Run(fmt.Sprintf("Test %s of %T dispatches through the backend", name, f.m), func(t *testing.T) {
+				clear(counting.calls)
+				if _, err := op(); err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				if counting.calls["Axpy"]+counting.calls["Scal"] == 0 {
+					t.Errorf("backend calls = %v, want Axpy or Scal", counting.calls)
+				}
+			})
+		}
+	}
+}
+
+func TestStructured_MulPropagatesNaN(t *testing.T) {
+	// Every matrix stores zeros inside its support, which must meet the Inf and NaN elements
+	// of other like they do in FlatMatrix.Mul. Elements outside the support are never read.
+	tests := []struct {
+		m       func() (Matrix, error)
+		dense   []float64
+		support [][2]int
+	}{
+		{
+			m:       func() (Matrix, error) { return NewDiagonal([]float64{0, 1, 0}) },
+			dense:   []float64{0, 0, 0, 0, 1, 0, 0, 0, 0},
+			support: [][2]int{{0, 1}, {1, 2}, {2, 3}},
+		},
+		{
+			m:       func() (Matrix, error) { return NewTriangular(3, false, []float64{0, 1, 0, 2, 0, 3}) },
+			dense:   []float64{0, 0, 0, 1, 0, 0, 2, 0, 3},
+			support: [][2]int{{0, 1}, {0, 2}, {0, 3}},
+		},
+		{
+			m:       func() (Matrix, error) { return NewBanded(3, 3, 1, 1, []float64{9, 0, 1, 0, 2, 0, 3, 0, 9}) },
+			dense:   []float64{0, 1, 0, 0, 2, 0, 0, 3, 0},
+			support: [][2]int{{0, 2}, {0, 3}, {1, 3}},
+		},
+	}
+	other := []float64{math.Inf(1), 1, 1, 1, math.Inf(-1), 1, 1, 1, math.NaN()}
+	for _, tt := range tests {
+		m, err := tt.m()
+		if err != nil {
+			t.Fatalf("constructor error = %v", err)
+		}
+		t.Run(fmt.Sprintf("Test Mul of %T", m), func(t *testing.T) {
+			got, err := m.Mul(&FlatMatrix{data: other, rows: 3, cols: 3})
+			if err != nil {
+				t.Fatalf("Mul() error = %v", err)
+			}
+			for i := 0; i < 3; i++ {
+				for j := 0; j < 3; j++ {
+					var want float64
+					for k := tt.support[i][0]; k < tt.support[i][1]; k++ {
+						want += tt.dense[i*3+k] * other[k*3+j]
+					}
+					if g := got.MustAt(i, j); g != want && !(math.IsNaN(g) && math.IsNaN(want)) {
+						t.Errorf("Mul()[%d][%d] = %v, want %v", i, j, g, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestBanded_SubDifferentBandwidths(t *testing.T) {
+	upper, _ := NewBanded(4, 3, 0, 2, []float64{1, 2, 3, 4, 5, 9, 6, 9, 9, 9, 9, 9})
+	lower, _ := NewBanded(4, 3, 1, 0, []float64{9, 1, 2, 3, 4, 5, 6, 9})
+	got, err := upper.Sub(lower)
+	if err != nil {
+		t.Fatalf("Sub() error = %v", err)
+	}
+	if _, ok := got.(*BandedMatrix); !ok {
+		t.Errorf("Sub() = %T, want *BandedMatrix", got)
+	}
+	want := &FlatMatrix{data: []float64{0, 2, 3, -2, 1, 5, 0, -4, 1, 0, 0, -6}, rows: 4, cols: 3}
+	if flat := mustFlat(t, got); !reflect.DeepEqual(flat, want) {
+		t.Errorf("Sub() = %v, want %v", flat, want)
+	}
+}
+
+func TestBanded_Empty(t *testing.T) {
+	for _, shape := range [][2]int{{0, 3}, {3, 0}, {0, 0}} {
+		m, err := NewBanded(shape[0], shape[1], 0, 0, make([]float64, shape[0]))
+		if err != nil {
+			t.Fatalf("NewBanded() error = %v", err)
+		}
+		want := (&FlatMatrix{data: []float64{}, rows: shape[0], cols: shape[1]}).Empty()
+		if got := m.Empty(); got != want {
+			t.Errorf("BandedMatrix.Empty() of a %dx%d matrix = %v, want %v like FlatMatrix", shape[0], shape[1], got, want)
+		}
+	}
+}
+
+func TestBanded_RowMajorLayout(t *testing.T) {
+	// Row i holds columns i-kl through i+ku; the last position of row 2 is column 4, outside
+	// the matrix.
+	m, err := NewBanded(3, 4, 0, 2, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	if err != nil {
+		t.Fatalf("NewBanded() error = %v", err)
+	}
+	want := &FlatMatrix{data: []float64{1, 2, 3, 0, 0, 4, 5, 6, 0, 0, 7, 8}, rows: 3, cols: 4}
+	if got := mustFlat(t, m); !reflect.DeepEqual(got, want) {
+		t.Errorf("At() elements = %v, want %v", got, want)
+	}
+}
+
+func TestStructured_PreservesStructure(t *testing.T) {
+	fixtures := structuredFixtures(t)
+	diagonal, upper, lower, symmetric, banded := fixtures[0].m, fixtures[1].m, fixtures[2].m, fixtures[3].m, fixtures[4].m
+	product := func(a, b Matrix) Matrix {
+		m, _ := a.Mul(b)
+		return m
+	}
+	sum := func(a, b Matrix) Matrix {
+		m, _ := a.Add(b)
+		return m
+	}
+	scaled := func(a Matrix) Matrix {
+		m, _ := a.ScalarMul(2)
+		return m
+	}
+
+	tests := []struct {
+		name string
+		got  Matrix
+		want Matrix
+	}{
+		{name: "Test product of diagonal matrices", got: product(diagonal, diagonal), want: &DiagonalMatrix{}},
+		{name: "Test product of upper triangular matrices", got: product(upper, upper), want: &TriangularMatrix{}},
+		{name: "Test product of lower triangular matrices", got: product(lower, lower), want: &TriangularMatrix{}},
+		{name: "Test product of banded matrices", got: product(banded, banded), want: &BandedMatrix{}},
+		{name: "Test product of upper and lower triangular matrices", got: product(upper, lower), want: &FlatMatrix{}},
+		{name: "Test product of symmetric matrices", got: product(symmetric, symmetric), want: &FlatMatrix{}},
+		{name: "Test sum of symmetric and diagonal matrices", got: sum(symmetric, diagonal), want: &SymmetricMatrix{}},
+		{name: "Test sum of upper triangular matrices", got: sum(upper, upper), want: &TriangularMatrix{}},
+		{name: "Test sum of banded matrices", got: sum(banded, banded), want: &BandedMatrix{}},
+		{name: "Test sum of diagonal and upper triangular matrices", got: sum(diagonal, upper), want: &FlatMatrix{}},
+		{name: "Test scaled diagonal matrix", got: scaled(diagonal), want: &DiagonalMatrix{}},
+		{name: "Test scaled triangular matrix", got: scaled(lower), want: &TriangularMatrix{}},
+		{name: "Test scaled symmetric matrix", got: scaled(symmetric), want: &SymmetricMatrix{}},
+		{name: "Test scaled banded matrix", got: scaled(banded), want: &BandedMatrix{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if reflect.TypeOf(tt.got) != reflect.TypeOf(tt.want) {
+				t.Errorf("result is a %T, want a %T", tt.got, tt.want)
+			}
+		})
+	}
+
+	if u := product(upper, upper).(*TriangularMatrix); !u.Upper() {
+		t.Errorf("product of upper triangular matrices is lower triangular")
+	}
+	if kl, ku := product(banded, banded).(*BandedMatrix).Bandwidth(); kl != 2 || ku != 2 {
+		t.Errorf("Bandwidth() of banded product = %d, %d, want 2, 2", kl, ku)
+	}
+}
+
+func TestStructured_TransposeScalarMulVec(t *testing.T) {
+	for _, f := range structuredFixtures(t) {
+		t.Run(fmt.Sprintf("Test Transpose, ScalarMul and MulVec of %T", f.m), func(t *testing.T) {
+			if got, want := mustFlat(t, f.m.Transpose()), f.dense.Transpose(); !reflect.DeepEqual(got, want) {
+				t.Errorf("Transpose() = %v, want %v", got, want)
+			}
+
+			wantScaled, _ := f.dense.ScalarMul(-2)
+			scaled, _ := f.m.ScalarMul(-2)
+			if got := mustFlat(t, scaled); !reflect.DeepEqual(got, wantScaled) {
+				t.Errorf("ScalarMul() = %v, want %v", got, wantScaled)
+			}
+
+			mv := f.m.(interface {
+				MulVec(x []float64) ([]float64, error)
+			})
+			x := []float64{1, -1, 2}
+			want, _ := f.dense.MulVec(x)
+			if got, err := mv.MulVec(x); err != nil || !reflect.DeepEqual(got, want) {
+				t.Errorf("MulVec() = %v, %v, want %v", got, err, want)
+			}
+			if _, err := mv.MulVec(x[:2]); err != ErrInvalidDimensions {
+				t.Errorf("MulVec() error = %v, want %v", err, ErrInvalidDimensions)
+			}
+		})
+	}
+}
+
+func TestStructured_Errors(t *testing.T) {
+	for _, f := range structuredFixtures(t) {
+		if _, err := f.m.Add(nil); err != ErrNilMatrix {
+			t.Errorf("%T.Add() error = %v, want %v", f.m, err, ErrNilMatrix)
+		}
+		if _, err := f.m.Sub(&FlatMatrix{data: []float64{1}, rows: 1, cols: 1}); err != ErrInvalidDimensions {
+			t.Errorf("%T.Sub() error = %v, want %v", f.m, err, ErrInvalidDimensions)
+		}
+		if _, err := f.m.Mul(nil); err != ErrNilMatrix {
+			t.Errorf("%T.Mul() error = %v, want %v", f.m, err, ErrNilMatrix)
+		}
+		if _, err := f.m.Mul(&FlatMatrix{data: []float64{1, 2}, rows: 2, cols: 1}); err != ErrMulDimensions {
+			t.Errorf("%T.Mul() error = %v, want %v", f.m, err, ErrMulDimensions)
+		}
+	}
+}
+
+func TestStructured_Solve(t *testing.T) {
+	fixtures := structuredFixtures(t)
+	tests := []struct {
+		name    string
+		solve   func(b []float64) ([]float64, error)
+		b       []float64
+		want    []float64
+		wantErr error
+	}{
+		{
+			name:  "Test Solve of diagonal matrix",
+			solve: fixtures[0].m.(*DiagonalMatrix).Solve,
+			b:     []float64{1, 4, 9},
+			want:  []float64{1, 2, 3},
+		},
+		{
+			name:  "Test Solve of upper triangular matrix",
+			solve: fixtures[1].m.(*TriangularMatrix).Solve,
+			b:     []float64{14, 23, 18},
+			want:  []float64{1, 2, 3},
+		},
+		{
+			name:  "Test Solve of lower triangular matrix",
+			solve: fixtures[2].m.(*TriangularMatrix).Solve,
+			b:     []float64{1, 8, 32},
+			want:  []float64{1, 2, 3},
+		},
+		{
+			name:    "Test Solve of singular diagonal matrix should return error",
+			solve:   (&DiagonalMatrix{diag: []float64{1, 0}}).Solve,
+			b:       []float64{1, 1},
+			wantErr: ErrSingularMatrix,
+		},
+		{
+			name:    "Test Solve of singular triangular matrix should return error",
+			solve:   (&TriangularMatrix{n: 2, upper: true, data: []float64{1, 1, 0}}).Solve,
+			b:       []float64{1, 1},
+			wantErr: ErrSingularMatrix,
+		},
+		{
+			name:    "Test Solve with wrong length should return error",
+			solve:   fixtures[1].m.(*TriangularMatrix).Solve,
+			b:       []float64{1, 1},
+			wantErr: ErrInvalidDimensions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.solve(tt.b)
+			if err != tt.wantErr {
+				t.Fatalf("Solve() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Solve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,481 @@
+package algebra
+
+import (
+	"math"
+	"slices"
+)
+
+// Tensor is an N-dimensional array of float64 elements. The element at index (i₀, i₁, ...)
+// is stored at data[offset + i₀*strides[0] + i₁*strides[1] + ...], so reshaping a contiguous
+// tensor and transposing any tensor return views sharing storage with it.
+// A tensor with no dimensions is a scalar holding a single element.
+type Tensor struct {
+	data    []float64
+	shape   []int
+	strides []int
+	offset  int
+}
+
+// NewTensor returns a tensor of the given shape backed by data, stored row-major
+// (last index varying fastest). data must hold exactly the product of the dimensions.
+func NewTensor(data []float64, shape ...int) (*Tensor, error) {
+	size, err := shapeSize(shape)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) != size {
+		return nil, ErrInvalidDimensions
+	}
+
+	return &Tensor{data: data, shape: slices.Clone(shape), strides: rowMajorStrides(shape)}, nil
+}
+
+// NewTensorZero returns a tensor of the given shape filled with zeros.
+func NewTensorZero(shape ...int) (*Tensor, error) {
+	size, err := shapeSize(shape)
+	if err != nil {
+		return nil, err
+	}
+	return NewTensor(make([]float64, size), shape...)
+}
+
+// TensorFromMatrix returns a copy of the elements of m as a 2-D tensor.
+func TensorFromMatrix(m Matrix) (*Tensor, error) {
+	flat, err := ToFlat(m)
+	if err != nil {
+		return nil, err
+	}
+	return NewTensor(flat.data, flat.rows, flat.cols)
+}
+
+// ToMatrix returns a copy of the elements of a 2-D tensor as a FlatMatrix.
+func (t *Tensor) ToMatrix() (Matrix, error) {
+	if len(t.shape) != 2 {
+		return nil, ErrInvalidDimensions
+	}
+	return NewMatrixFlat(t.Data(), t.shape[0], t.shape[1])
+}
+
+// Shape returns the length of every dimension.
+func (t *Tensor) Shape() []int {
+	return append([]int{}, t.shape...)
+}
+
+// Strides returns the distance in the backing storage between consecutive indices of every
+// dimension.
+func (t *Tensor) Strides() []int {
+	return append([]int{}, t.strides...)
+}
+
+// NDim returns the number of dimensions.
+func (t *Tensor) NDim() int {
+	return len(t.shape)
+}
+
+// Size returns the number of elements.
+func (t *Tensor) Size() int {
+	size, _ := shapeSize(t.shape)
+	return size
+}
+
+// Data returns a copy of the elements in row-major order.
+func (t *Tensor) Data() []float64 {
+	result := make([]float64, 0, t.Size())
+	t.each(func(p int) {
+		result = append(result, t.data[p])
+	})
+	return result
+}
+
+func (t *Tensor) At(index ...int) (float64, error) {
+	p, err := t.position(index)
+	if err != nil {
+		return 0, err
+	}
+	return t.data[p], nil
+}
+
+func (t *Tensor) MustAt(index ...int) (v float64) {
+	var err error
+	if v, err = t.At(index...); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Set stores v at the given index. Views share storage, so the change is visible through
+// every tensor derived from the same data.
+func (t *Tensor) Set(v float64, index ...int) error {
+	p, err := t.position(index)
+	if err != nil {
+		return err
+	}
+	t.data[p] = v
+	return nil
+}
+
+// Reshape returns a tensor with the same elements in row-major order and the given shape.
+// One dimension may be -1, in which case it is inferred from the size. The result shares
+// storage with t when t is contiguous and is a copy otherwise.
+func (t *Tensor) Reshape(shape ...int) (*Tensor, error) {
+	shape = slices.Clone(shape)
+	inferred := -1
+	known := 1
+	for axis, d := range shape {
+		switch {
+		case d == -1 && inferred < 0:
+			inferred = axis
+		case d < 0:
+			return nil, ErrInvalidDimensions
+		default:
+			known *= d
+		}
+	}
+
+	size := t.Size()
+	if inferred >= 0 {
+		if known == 0 || size%known != 0 {
+			return nil, ErrInvalidDimensions
+		}
+		shape[inferred] = size / known
+	} else if known != size {
+		return nil, ErrInvalidDimensions
+	}
+
+	if t.contiguous() {
+		return &Tensor{data: t.data, shape: shape, strides: rowMajorStrides(shape), offset: t.offset}, nil
+	}
+	return NewTensor(t.Data(), shape...)
+}
+
+// Transpose returns a view of t with its dimensions permuted: dimension i of the result is
+// dimension axes[i] of t. Without axes the order of the dimensions is reversed.
+func (t *Tensor) Transpose(axes ...int) (*Tensor, error) {
+	n := len(t.shape)
+	if len(axes) == 0 {
+		axes = make([]int, n)
+		for i := range axes {
+			axes[i] = n - 1 - i
+		}
+	}
+
+	if len(axes) != n {
+		return nil, ErrInvalidAxis
+	}
+
+	result := &Tensor{data: t.data, shape: make([]int, n), strides: make([]int, n), offset: t.offset}
+	seen := make([]bool, n)
+	for i, axis := range axes {
+		axis, err := t.axis(axis)
+		if err != nil || seen[axis] {
+			return nil, ErrInvalidAxis
+		}
+		seen[axis] = true
+		result.shape[i] = t.shape[axis]
+		result.strides[i] = t.strides[axis]
+	}
+	return result, nil
+}
+
+// Add returns the element-wise sum of t and other, broadcasting their shapes.
+func (t *Tensor) Add(other *Tensor) (*Tensor, error) {
+	return t.broadcast(other, func(a, b float64) float64 { return a + b })
+}
+
+// Sub returns the element-wise difference of t and other, broadcasting their shapes.
+func (t *Tensor) Sub(other *Tensor) (*Tensor, error) {
+	return t.broadcast(other, func(a, b float64) float64 { return a - b })
+}
+
+// Mul returns the element-wise product of t and other, broadcasting their shapes.
+func (t *Tensor) Mul(other *Tensor) (*Tensor, error) {
+	return t.broadcast(other, func(a, b float64) float64 { return a * b })
+}
+
+// Div returns the element-wise quotient of t and other, broadcasting their shapes.
+func (t *Tensor) Div(other *Tensor) (*Tensor, error) {
+	return t.broadcast(other, func(a, b float64) float64 { return a / b })
+}
+
+// Scale returns alpha*t. A zero alpha still multiplies every element, so NaNs and infinities
+// become NaN like they do in Vector.Scale and FlatMatrix.ScalarMul.
+func (t *Tensor) Scale(alpha float64) *Tensor {
+	data := t.Data()
+	backend().Scal(alpha, data)
+	result, _ := NewTensor(data, t.shape...)
+	return result
+}
+
+// Sum returns the sum of the elements along the given axes, which are removed from the shape.
+// Without axes all elements are summed into a scalar tensor. Negative axes count from the end.
+func (t *Tensor) Sum(axes ...int) (*Tensor, error) {
+	return t.reduce(axes, 0, func(acc, v float64) float64 { return acc + v })
+}
+
+// Prod returns the product of the elements along the given axes, like Sum.
+func (t *Tensor) Prod(axes ...int) (*Tensor, error) {
+	return t.reduce(axes, 1, func(acc, v float64) float64 { return acc * v })
+}
+
+// Max returns the largest element along the given axes, like Sum.
+// Returns ErrInvalidDimensions when reducing over an empty axis.
+func (t *Tensor) Max(axes ...int) (*Tensor, error) {
+	if t.emptyReduction(axes) {
+		return nil, ErrInvalidDimensions
+	}
+	return t.reduce(axes, math.Inf(-1), func(acc, v float64) float64 { return max(acc, v) })
+}
+
+// Min returns the smallest element along the given axes, like Sum.
+// Returns ErrInvalidDimensions when reducing over an empty axis.
+func (t *Tensor) Min(axes ...int) (*Tensor, error) {
+	if t.emptyReduction(axes) {
+		return nil, ErrInvalidDimensions
+	}
+	return t.reduce(axes, math.Inf(1), func(acc, v float64) float64 { return min(acc, v) })
+}
+
+// Mean returns the arithmetic mean of the elements along the given axes, like Sum.
+// Returns ErrInvalidDimensions when reducing over an empty axis.
+func (t *Tensor) Mean(axes ...int) (*Tensor, error) {
+	if t.emptyReduction(axes) {
+		return nil, ErrInvalidDimensions
+	}
+
+	sum, err := t.Sum(axes...)
+	if err != nil {
+		return nil, err
+	}
+	return sum.Scale(float64(sum.Size()) / float64(t.Size())), nil
+}
+
+// broadcast applies f element-wise to t and other after broadcasting both to a common shape
+// following the NumPy rules: shapes are aligned on their last dimension, and every pair of
+// dimensions must be equal or contain a 1, which is stretched to the other length.
+func (t *Tensor) broadcast(other *Tensor, f func(a, b float64) float64) (*Tensor, error) {
+	if other == nil {
+		return nil, ErrNilMatrix
+	}
+
+	n := max(len(t.shape), len(other.shape))
+	shape := make([]int, n)
+	for i := 0; i < n; i++ {
+		a, b := dimFromEnd(t.shape, n-1-i), dimFromEnd(other.shape, n-1-i)
+		switch {
+		case a == b || b == 1:
+			shape[i] = a
+		case a == 1:
+			shape[i] = b
+		default:
+			return nil, ErrNotBroadcastable
+		}
+	}
+
+	result, _ := NewTensorZero(shape...)
+	ts, os := t.broadcastStrides(shape), other.broadcastStrides(shape)
+	i := 0
+	walk(shape, [][]int{ts, os}, []int{t.offset, other.offset}, func(p []int) {
+		result.data[i] = f(t.data[p[0]], other.data[p[1]])
+		i++
+	})
+	return result, nil
+}
+
+// broadcastStrides returns the strides that map an index into the broadcast shape onto the
+// storage of t: missing leading dimensions and stretched dimensions get a stride of zero.
+func (t *Tensor) broadcastStrides(shape []int) []int {
+	strides := make([]int, len(shape))
+	lead := len(shape) - len(t.shape)
+	for i, d := range t.shape {
+		if d != 1 {
+			strides[lead+i] = t.strides[i]
+		}
+	}
+	return strides
+}
+
+// reduce folds the elements along the given axes with f, starting every fold from init.
+func (t *Tensor) reduce(axes []int, init float64, f func(acc, v float64) float64) (*Tensor, error) {
+	reduced, err := t.reducedAxes(axes)
+	if err != nil {
+		return nil, err
+	}
+
+	var shape []int
+	for axis, d := range t.shape {
+		if !reduced[axis] {
+			shape = append(shape, d)
+		}
+	}
+	result, _ := NewTensorZero(shape...)
+	for i := range result.data {
+		result.data[i] = init
+	}
+
+	// Reduced axes get a stride of zero in the result, so their elements fold into one slot.
+	resultStrides := make([]int, len(t.shape))
+	k := 0
+	for axis := range t.shape {
+		if !reduced[axis] {
+			resultStrides[axis] = result.strides[k]
+			k++
+		}
+	}
+
+	walk(t.shape, [][]int{t.strides, resultStrides}, []int{t.offset, 0}, func(p []int) {
+		result.data[p[1]] = f(result.data[p[1]], t.data[p[0]])
+	})
+	return result, nil
+}
+
+// reducedAxes returns which axes a reduction over axes covers; all of them when axes is empty.
+func (t *Tensor) reducedAxes(axes []int) ([]bool, error) {
+	reduced := make([]bool, len(t.shape))
+	if len(axes) == 0 {
+		for axis := range reduced {
+			reduced[axis] = true
+		}
+		return reduced, nil
+	}
+
+	for _, axis := range axes {
+		axis, err := t.axis(axis)
+		if err != nil || reduced[axis] {
+			return nil, ErrInvalidAxis
+		}
+		reduced[axis] = true
+	}
+	return reduced, nil
+}
+
+// emptyReduction reports whether a reduction over axes folds empty sets of elements.
+func (t *Tensor) emptyReduction(axes []int) bool {
+	reduced, err := t.reducedAxes(axes)
+	if err != nil {
+		return false
+	}
+
+	for axis, d := range t.shape {
+		if reduced[axis] && d == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// axis resolves a possibly negative axis into the range [0, NDim()).
+func (t *Tensor) axis(axis int) (int, error) {
+	if axis < 0 {
+		axis += len(t.shape)
+	}
+
+	if axis < 0 || axis >= len(t.shape) {
+		return 0, ErrInvalidAxis
+	}
+	return axis, nil
+}
+
+// position returns the position in data of the element at index.
+func (t *Tensor) position(index []int) (int, error) {
+	if len(index) != len(t.shape) {
+		return 0, ErrInvalidDimensions
+	}
+
+	p := t.offset
+	for axis, i := range index {
+		if i < 0 || i >= t.shape[axis] {
+			return 0, ErrorIndexOutOfBounds
+		}
+		p += i * t.strides[axis]
+	}
+	return p, nil
+}
+
+// contiguous reports whether the elements are laid out row-major without gaps.
+func (t *Tensor) contiguous() bool {
+	return slices.Equal(t.strides, rowMajorStrides(t.shape)) || t.Size() <= 1
+}
+
+// compact returns t if it is contiguous and a contiguous copy of it otherwise.
+func (t *Tensor) compact() *Tensor {
+	if t.contiguous() {
+		return t
+	}
+
+	result, _ := NewTensor(t.Data(), t.shape...)
+	return result
+}
+
+// each calls f with the position in data of every element, in row-major order.
+func (t *Tensor) each(f func(p int)) {
+	walk(t.shape, [][]int{t.strides}, []int{t.offset}, func(p []int) {
+		f(p[0])
+	})
+}
+
+// walk visits every index of shape in row-major order, calling f with the positions the index
+// maps to under each set of strides, starting from the given offsets.
+func walk(shape []int, strides [][]int, offsets []int, f func(p []int)) {
+	for _, d := range shape {
+		if d == 0 {
+			return
+		}
+	}
+
+	p := slices.Clone(offsets)
+	index := make([]int, len(shape))
+	for {
+		f(p)
+
+		// Advance the index like an odometer, last axis first.
+		axis := len(shape) - 1
+		for ; axis >= 0; axis-- {
+			index[axis]++
+			for k := range p {
+				p[k] += strides[k][axis]
+			}
+			if index[axis] < shape[axis] {
+				break
+			}
+			for k := range p {
+				p[k] -= index[axis] * strides[k][axis]
+			}
+			index[axis] = 0
+		}
+		if axis < 0 {
+			return
+		}
+	}
+}
+
+// rowMajorStrides returns the strides of a contiguous row-major tensor of the given shape.
+func rowMajorStrides(shape []int) []int {
+	strides := make([]int, len(shape))
+	stride := 1
+	for axis := len(shape) - 1; axis >= 0; axis-- {
+		strides[axis] = stride
+		stride *= shape[axis]
+	}
+	return strides
+}
+
+// shapeSize returns the number of elements of a tensor of the given shape.
+func shapeSize(shape []int) (int, error) {
+	size := 1
+	for _, d := range shape {
+		if d < 0 {
+			return 0, ErrInvalidDimensions
+		}
+		size *= d
+	}
+	return size, nil
+}
+
+// dimFromEnd returns dimension i of shape counted from the end, or 1 if shape is too short.
+func dimFromEnd(shape []int, i int) int {
+	if i >= len(shape) {
+		return 1
+	}
+	return shape[len(shape)-1-i]
+}
@@ -0,0 +1,301 @@
+package algebra
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func mustTensor(t *testing.T, data []float64, shape ...int) *Tensor {
+	t.Helper()
+	tensor, err := NewTensor(data, shape...)
+	if err != nil {
+		t.Fatalf("NewTensor() error = %v", err)
+	}
+	return tensor
+}
+
+// arange returns a tensor of the given shape holding 0, 1, 2, ... in row-major order.
+func arange(t *testing.T, shape ...int) *Tensor {
+	t.Helper()
+	tensor, err := NewTensorZero(shape...)
+	if err != nil {
+		t.Fatalf("NewTensorZero() error = %v", err)
+	}
+	for i := range tensor.data {
+		tensor.data[i] = float64(i)
+	}
+	return tensor
+}
+
+func assertTensor(t *testing.T, name string, got *Tensor, shape []int, data []float64) {
+	t.Helper()
+	if !reflect.DeepEqual(got.Shape(), shape) || !reflect.DeepEqual(got.Data(), data) {
+		t.Errorf("%s = %v %v, want %v %v", name, got.Shape(), got.Data(), shape, data)
+	}
+}
+
+func TestNewTensor(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []float64
+		shape   []int
+		wantErr error
+	}{
+		{name: "Test 2x3 tensor", data: make([]float64, 6), shape: []int{2, 3}},
+		{name: "Test scalar tensor", data: []float64{7}, shape: []int{}},
+		{name: "Test empty tensor", data: []float64{}, shape: []int{2, 0, 3}},
+		{name: "Test data length mismatch should return error", data: make([]float64, 5), shape: []int{2, 3}, wantErr: ErrInvalidDimensions},
+		{name: "Test negative dimension should return error", data: []float64{}, shape: []int{-1, 0}, wantErr: ErrInvalidDimensions},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTensor(tt.data, tt.shape...)
+			if err != tt.wantErr {
+				t.Fatalf("NewTensor() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (got.NDim() != len(tt.shape) || got.Size() != len(tt.data)) {
+				t.Errorf("NewTensor() NDim = %d, Size = %d", got.NDim(), got.Size())
+			}
+		})
+	}
+}
+
+func TestTensor_AtSet(t *testing.T) {
+	tensor := arange(t, 2, 3, 4)
+	if got := tensor.MustAt(1, 2, 3); got != 23 {
+		t.Errorf("MustAt(1, 2, 3) = %v, want 23", got)
+	}
+	if !reflect.DeepEqual(tensor.Strides(), []int{12, 4, 1}) {
+		t.Errorf("Strides() = %v, want [12 4 1]", tensor.Strides())
+	}
+
+	if err := tensor.Set(-1, 0, 1, 2); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if got := tensor.MustAt(0, 1, 2); got != -1 {
+		t.Errorf("MustAt(0, 1, 2) = %v, want -1", got)
+	}
+
+	if _, err := tensor.At(2, 0, 0); err != ErrorIndexOutOfBounds {
+		t.Errorf("At() error = %v, want %v", err, ErrorIndexOutOfBounds)
+	}
+	if _, err := tensor.At(0, 0); err != ErrInvalidDimensions {
+		t.Errorf("At() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+}
+
+func TestTensor_Reshape(t *testing.T) {
+	tensor := arange(t, 2, 3, 4)
+	data := tensor.Data()
+
+	tests := []struct {
+		name    string
+		shape   []int
+		want    []int
+		wantErr error
+	}{
+		{name: "Test reshape to matrix", shape: []int{6, 4}, want: []int{6, 4}},
+		{name: "Test reshape with inferred dimension", shape: []int{4, -1}, want: []int{4, 6}},
+		{name: "Test flatten", shape: []int{-1}, want: []int{24}},
+		{name: "Test size mismatch should return error", shape: []int{5, 5}, wantErr: ErrInvalidDimensions},
+		{name: "Test two inferred dimensions should return error", shape: []int{-1, -1}, wantErr: ErrInvalidDimensions},
+		{name: "Test indivisible inferred dimension should return error", shape: []int{5, -1}, wantErr: ErrInvalidDimensions},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tensor.Reshape(tt.shape...)
+			if err != tt.wantErr {
+				t.Fatalf("Reshape() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil {
+				assertTensor(t, "Reshape()", got, tt.want, data)
+			}
+		})
+	}
+
+	view, _ := tensor.Reshape(24)
+	view.Set(100, 0)
+	if tensor.MustAt(0, 0, 0) != 100 {
+		t.Error("Reshape() of a contiguous tensor should share storage")
+	}
+}
+
+func TestTensor_Transpose(t *testing.T) {
+	tensor := arange(t, 2, 3)
+
+	transposed, err := tensor.Transpose()
+	if err != nil {
+		t.Fatalf("Transpose() error = %v", err)
+	}
+	assertTensor(t, "Transpose()", transposed, []int{3, 2}, []float64{0, 3, 1, 4, 2, 5})
+
+	// Reshaping a non-contiguous view copies it in its own row-major order.
+	flat, _ := transposed.Reshape(-1)
+	assertTensor(t, "Reshape()", flat, []int{6}, []float64{0, 3, 1, 4, 2, 5})
+	flat.Set(100, 0)
+	if tensor.MustAt(0, 0) != 0 {
+		t.Error("Reshape() of a non-contiguous tensor should copy it")
+	}
+
+	cube := arange(t, 2, 3, 4)
+	permuted, err := cube.Transpose(2, 0, -2)
+	if err != nil {
+		t.Fatalf("Transpose() error = %v", err)
+	}
+	if !reflect.DeepEqual(permuted.Shape(), []int{4, 2, 3}) || permuted.MustAt(3, 1, 2) != cube.MustAt(1, 2, 3) {
+		t.Errorf("Transpose(2, 0, 1) = %v %v", permuted.Shape(), permuted.Data())
+	}
+
+	for _, axes := range [][]int{{0, 1}, {0, 0, 1}, {0, 1, 3}} {
+		if _, err := cube.Transpose(axes...); err != ErrInvalidAxis {
+			t.Errorf("Transpose(%v) error = %v, want %v", axes, err, ErrInvalidAxis)
+		}
+	}
+}
+
+func TestTensor_Broadcast(t *testing.T) {
+	matrix := arange(t, 2, 3)
+	tests := []struct {
+		name    string
+		op      func(a, b *Tensor) (*Tensor, error)
+		a, b    *Tensor
+		shape   []int
+		want    []float64
+		wantErr error
+	}{
+		{
+			name: "Test add same shape", op: (*Tensor).Add, a: matrix, b: matrix,
+			shape: []int{2, 3}, want: []float64{0, 2, 4, 6, 8, 10},
+		},
+		{
+			name: "Test add row vector", op: (*Tensor).Add, a: matrix, b: mustTensor(t, []float64{10, 20, 30}, 3),
+			shape: []int{2, 3}, want: []float64{10, 21, 32, 13, 24, 35},
+		},
+		{
+			name: "Test sub column vector", op: (*Tensor).Sub, a: matrix, b: mustTensor(t, []float64{1, 2}, 2, 1),
+			shape: []int{2, 3}, want: []float64{-1, 0, 1, 1, 2, 3},
+		},
+		{
+			name: "Test mul scalar", op: (*Tensor).Mul, a: mustTensor(t, []float64{2}), b: matrix,
+			shape: []int{2, 3}, want: []float64{0, 2, 4, 6, 8, 10},
+		},
+		{
+			name: "Test div outer broadcast", op: (*Tensor).Div, a: mustTensor(t, []float64{6, 12}, 2, 1), b: mustTensor(t, []float64{1, 2, 3}, 1, 3),
+			shape: []int{2, 3}, want: []float64{6, 3, 2, 12, 6, 4},
+		},
+		{
+			name: "Test broadcast transposed view", op: (*Tensor).Add, a: mustTranspose(t, matrix), b: mustTensor(t, []float64{0, 10}, 2),
+			shape: []int{3, 2}, want: []float64{0, 13, 1, 14, 2, 15},
+		},
+		{
+			name: "Test incompatible shapes should return error", op: (*Tensor).Add, a: matrix, b: mustTensor(t, []float64{1, 2}, 2),
+			wantErr: ErrNotBroadcastable,
+		},
+		{
+			name: "Test nil operand should return error", op: (*Tensor).Add, a: matrix, b: nil,
+			wantErr: ErrNilMatrix,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op(tt.a, tt.b)
+			if err != tt.wantErr {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil {
+				assertTensor(t, "result", got, tt.shape, tt.want)
+			}
+		})
+	}
+}
+
+func TestTensor_Scale(t *testing.T) {
+	assertTensor(t, "Scale()", mustTranspose(t, arange(t, 2, 3)).Scale(2), []int{3, 2}, []float64{0, 6, 2, 8, 4, 10})
+
+	// Scaling by zero must match Vector.Scale, which keeps NaN for NaN and Inf elements.
+	data := []float64{1, math.Inf(1), math.NaN()}
+	got := mustTensor(t, data, 3).Scale(0).Data()
+	want := Vector(data).Scale(0)
+	for i := range want {
+		if got[i] != want[i] && !(math.IsNaN(got[i]) && math.IsNaN(want[i])) {
+			t.Errorf("Scale(0) = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func mustTranspose(t *testing.T, tensor *Tensor, axes ...int) *Tensor {
+	t.Helper()
+	result, err := tensor.Transpose(axes...)
+	if err != nil {
+		t.Fatalf("Transpose() error = %v", err)
+	}
+	return result
+}
+
+func TestTensor_Reductions(t *testing.T) {
+	cube := arange(t, 2, 3, 4)
+	tests := []struct {
+		name    string
+		reduce  func(t *Tensor, axes ...int) (*Tensor, error)
+		tensor  *Tensor
+		axes    []int
+		shape   []int
+		want    []float64
+		wantErr error
+	}{
+		{name: "Test sum all", reduce: (*Tensor).Sum, tensor: cube, shape: []int{}, want: []float64{276}},
+		{name: "Test sum first axis", reduce: (*Tensor).Sum, tensor: arange(t, 2, 3), axes: []int{0}, shape: []int{3}, want: []float64{3, 5, 7}},
+		{name: "Test sum last axis", reduce: (*Tensor).Sum, tensor: arange(t, 2, 3), axes: []int{-1}, shape: []int{2}, want: []float64{3, 12}},
+		{
+			name: "Test sum two axes", reduce: (*Tensor).Sum, tensor: cube, axes: []int{0, 2},
+			shape: []int{3}, want: []float64{60, 92, 124},
+		},
+		{name: "Test sum transposed view", reduce: (*Tensor).Sum, tensor: mustTranspose(t, arange(t, 2, 3)), axes: []int{1}, shape: []int{3}, want: []float64{3, 5, 7}},
+		{name: "Test prod", reduce: (*Tensor).Prod, tensor: mustTensor(t, []float64{1, 2, 3, 4}, 2, 2), axes: []int{1}, shape: []int{2}, want: []float64{2, 12}},
+		{name: "Test max", reduce: (*Tensor).Max, tensor: mustTensor(t, []float64{3, -1, 2, 5}, 2, 2), axes: []int{0}, shape: []int{2}, want: []float64{3, 5}},
+		{name: "Test min", reduce: (*Tensor).Min, tensor: mustTensor(t, []float64{3, -1, 2, 5}, 2, 2), axes: []int{1}, shape: []int{2}, want: []float64{-1, 2}},
+		{name: "Test mean", reduce: (*Tensor).Mean, tensor: cube, axes: []int{1, 2}, shape: []int{2}, want: []float64{5.5, 17.5}},
+		{name: "Test sum of empty axis", reduce: (*Tensor).Sum, tensor: arange(t, 0, 2), axes: []int{0}, shape: []int{2}, want: []float64{0, 0}},
+		{name: "Test max of empty axis should return error", reduce: (*Tensor).Max, tensor: arange(t, 0, 2), axes: []int{0}, wantErr: ErrInvalidDimensions},
+		{name: "Test mean of empty axis should return error", reduce: (*Tensor).Mean, tensor: arange(t, 2, 0), wantErr: ErrInvalidDimensions},
+		{name: "Test out of range axis should return error", reduce: (*Tensor).Sum, tensor: cube, axes: []int{3}, wantErr: ErrInvalidAxis},
+		{name: "Test repeated axis should return error", reduce: (*Tensor).Sum, tensor: cube, axes: []int{1, -2}, wantErr: ErrInvalidAxis},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.reduce(tt.tensor, tt.axes...)
+			if err != tt.wantErr {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil {
+				assertTensor(t, "result", got, tt.shape, tt.want)
+			}
+		})
+	}
+}
+
+func TestTensor_MatrixConversion(t *testing.T) {
+	m, _ := NewMatrix([][]float64{{1, 2, 3}, {4, 5, 6}})
+	tensor, err := TensorFromMatrix(m)
+	if err != nil {
+		t.Fatalf("TensorFromMatrix() error = %v", err)
+	}
+	assertTensor(t, "TensorFromMatrix()", tensor, []int{2, 3}, []float64{1, 2, 3, 4, 5, 6})
+
+	transposed, err := mustTranspose(t, tensor).ToMatrix()
+	if err != nil {
+		t.Fatalf("ToMatrix() error = %v", err)
+	}
+	if !reflect.DeepEqual(transposed, m.Transpose()) {
+		t.Errorf("ToMatrix() = %v, want %v", transposed, m.Transpose())
+	}
+
+	if _, err := arange(t, 2, 2, 2).ToMatrix(); err != ErrInvalidDimensions {
+		t.Errorf("ToMatrix() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+	if _, err := TensorFromMatrix(nil); err != ErrNilMatrix {
+		t.Errorf("TensorFromMatrix() error = %v, want %v", err, ErrNilMatrix)
+	}
+}
@@ -0,0 +1,205 @@
+package algebra
+
+import "math"
+
+// Vector is a column vector of float64 elements. Being a plain slice, it can be built with a
+// composite literal and passed wherever a []float64 is expected.
+type Vector []float64
+
+// VectorFromMatrix returns a copy of the elements of a 1 x n or n x 1 matrix as a Vector.
+func VectorFromMatrix(m Matrix) (Vector, error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if m.Rows() != 1 && m.Cols() != 1 {
+		return nil, ErrInvalidDimensions
+	}
+
+	v := make(Vector, 0, m.Rows()*m.Cols())
+	for i := 0; i < m.Rows(); i++ {
+		for j := 0; j < m.Cols(); j++ {
+			v = append(v, m.MustAt(i, j))
+		}
+	}
+	return v, nil
+}
+
+// MulVec returns the matrix-vector product m*v, using the MulVec method of m when it has one.
+func MulVec(m Matrix, v Vector) (Vector, error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+
+	if mv, ok := m.(interface {
+		MulVec(x []float64) ([]float64, error)
+	}); ok {
+		return mv.MulVec(v)
+	}
+	return mulVec(m, v)
+}
+
+// Len returns the number of elements of v.
+func (v Vector) Len() int {
+	return len(v)
+}
+
+// Add returns the element-wise sum v + w.
+func (v Vector) Add(w Vector) (Vector, error) {
+	if len(v) != len(w) {
+		return nil, ErrInvalidDimensions
+	}
+
+	result := append(Vector{}, v...)
+	backend().Axpy(1, w, result)
+	return result, nil
+}
+
+// Sub returns the element-wise difference v - w.
+func (v Vector) Sub(w Vector) (Vector, error) {
+	if len(v) != len(w) {
+		return nil, ErrInvalidDimensions
+	}
+
+	result := append(Vector{}, v...)
+	backend().Axpy(-1, w, result)
+	return result, nil
+}
+
+// Scale returns alpha*v.
+func (v Vector) Scale(alpha float64) Vector {
+	result := make(Vector, len(v))
+	backend().Axpy(alpha, v, result)
+	return result
+}
+
+// Dot returns the inner product of v and w.
+func (v Vector) Dot(w Vector) (float64, error) {
+	if len(v) != len(w) {
+		return 0, ErrInvalidDimensions
+	}
+	return backend().Dot(v, w), nil
+}
+
+// Norm returns the Euclidean (L2) norm of v, scaled to avoid overflow and underflow.
+func (v Vector) Norm() float64 {
+	largest := v.NormInf()
+	if largest == 0 || math.IsInf(largest, 0) {
+		return largest
+	}
+
+	var sum float64
+	for _, x := range v {
+		r := x / largest
+		sum += r * r
+	}
+	return largest * math.Sqrt(sum)
+}
+
+// Norm1 returns the L1 norm of v, the sum of the absolute values of its elements.
+func (v Vector) Norm1() float64 {
+	var sum float64
+	for _, x := range v {
+		sum += math.Abs(x)
+	}
+	return sum
+}
+
+// NormInf returns the L∞ norm of v, the largest absolute value of its elements.
+func (v Vector) NormInf() float64 {
+	var largest float64
+	for _, x := range v {
+		largest = max(largest, math.Abs(x))
+	}
+	return largest
+}
+
+// NormP returns the Lp norm of v for p >= 1, including p = +Inf.
+// Returns ErrInvalidNorm for p < 1, where the formula does not define a norm.
+func (v Vector) NormP(p float64) (float64, error) {
+	switch {
+	case math.IsNaN(p) || p < 1:
+		return 0, ErrInvalidNorm
+	case p == 1:
+		return v.Norm1(), nil
+	case p == 2:
+		return v.Norm(), nil
+	case math.IsInf(p, 1):
+		return v.NormInf(), nil
+	}
+
+	largest := v.NormInf()
+	if largest == 0 || math.IsInf(largest, 0) {
+		return largest, nil
+	}
+
+	var sum float64
+	for _, x := range v {
+		sum += math.Pow(math.Abs(x)/largest, p)
+	}
+	return largest * math.Pow(sum, 1/p), nil
+}
+
+// Cross returns the cross product v × w of two 3-dimensional vectors.
+func (v Vector) Cross(w Vector) (Vector, error) {
+	if len(v) != 3 || len(w) != 3 {
+		return nil, ErrInvalidDimensions
+	}
+
+	return Vector{
+		v[1]*w[2] - v[2]*w[1],
+		v[2]*w[0] - v[0]*w[2],
+		v[0]*w[1] - v[1]*w[0],
+	}, nil
+}
+
+// Normalize returns the unit vector pointing in the direction of v.
+// Returns ErrZeroVector if v has no direction.
+func (v Vector) Normalize() (Vector, error) {
+	norm := v.Norm()
+	if norm == 0 {
+		return nil, ErrZeroVector
+	}
+
+	result := make(Vector, len(v))
+	for i, x := range v {
+		result[i] = x / norm
+	}
+	return result, nil
+}
+
+// Project returns the orthogonal projection of v onto the line spanned by w.
+// Returns ErrZeroVector if w spans no line.
+func (v Vector) Project(w Vector) (Vector, error) {
+	if len(v) != len(w) {
+		return nil, ErrInvalidDimensions
+	}
+
+	ww, _ := w.Dot(w)
+	if ww == 0 {
+		return nil, ErrZeroVector
+	}
+
+	vw, _ := v.Dot(w)
+	return w.Scale(vw / ww), nil
+}
+
+// Outer returns the outer product v*wᵀ as a len(v) x len(w) matrix.
+func (v Vector) Outer(w Vector) Matrix {
+	result := &FlatMatrix{data: make([]float64, len(v)*len(w)), rows: len(v), cols: len(w)}
+	be := backend()
+	for i, x := range v {
+		be.Axpy(x, w, result.data[i*len(w):(i+1)*len(w)])
+	}
+	return result
+}
+
+// AsRow returns a copy of v as a 1 x len(v) matrix.
+func (v Vector) AsRow() Matrix {
+	return &FlatMatrix{data: append([]float64{}, v...), rows: 1, cols: len(v)}
+}
+
+// AsCol returns a copy of v as a len(v) x 1 matrix.
+func (v Vector) AsCol() Matrix {
+	return &FlatMatrix{data: append([]float64{}, v...), rows: len(v), cols: 1}
+}
@@ -0,0 +1,176 @@
+package algebra
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestVector_Arithmetic(t *testing.T) {
+	v := Vector{1, 2, 3}
+	w := Vector{4, 5, 6}
+
+	if got, err := v.Add(w); err != nil || !reflect.DeepEqual(got, Vector{5, 7, 9}) {
+		t.Errorf("Add() = %v, %v", got, err)
+	}
+	if got, err := v.Sub(w); err != nil || !reflect.DeepEqual(got, Vector{-3, -3, -3}) {
+		t.Errorf("Sub() = %v, %v", got, err)
+	}
+	if got := v.Scale(-2); !reflect.DeepEqual(got, Vector{-2, -4, -6}) {
+		t.Errorf("Scale() = %v", got)
+	}
+	if got, err := v.Dot(w); err != nil || got != 32 {
+		t.Errorf("Dot() = %v, %v, want 32", got, err)
+	}
+	if v.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", v.Len())
+	}
+
+	short := Vector{1}
+	if _, err := v.Add(short); err != ErrInvalidDimensions {
+		t.Errorf("Add() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+	if _, err := v.Sub(short); err != ErrInvalidDimensions {
+		t.Errorf("Sub() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+	if _, err := v.Dot(short); err != ErrInvalidDimensions {
+		t.Errorf("Dot() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+}
+
+func TestVector_Backend(t *testing.T) {
+	if err := RegisterBackend("counting", counting); err != nil && err != ErrBackendExists {
+		t.Fatalf("RegisterBackend() error = %v", err)
+	}
+	if err := SetBackend("counting"); err != nil {
+		t.Fatalf("SetBackend() error = %v", err)
+	}
+	defer SetBackend(DefaultBackend)
+	clear(counting.calls)
+
+	v, w := Vector{1, 2}, Vector{3, 4}
+	v.Add(w)
+	v.Sub(w)
+	v.Scale(2)
+	v.Dot(w)
+	v.Outer(w)
+
+	want := map[string]int{"Axpy": 5, "Dot": 1}
+	if !reflect.DeepEqual(counting.calls, want) {
+		t.Errorf("backend calls = %v, want %v", counting.calls, want)
+	}
+}
+
+func TestVector_Norms(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       Vector
+		p       float64
+		want    float64
+		wantErr error
+	}{
+		{name: "Test L1 norm", v: Vector{3, -4}, p: 1, want: 7},
+		{name: "Test L2 norm", v: Vector{3, -4}, p: 2, want: 5},
+		{name: "Test L3 norm", v: Vector{1, 1, 1, 1, 1, 1, 1, 1}, p: 3, want: 2},
+		{name: "Test L∞ norm", v: Vector{3, -4}, p: math.Inf(1), want: 4},
+		{name: "Test L2 norm without overflow", v: Vector{3e200, 4e200}, p: 2, want: 5e200},
+		{name: "Test L2 norm without underflow", v: Vector{3e-200, 4e-200}, p: 2, want: 5e-200},
+		{name: "Test Lp norm of zero vector", v: Vector{0, 0}, p: 4, want: 0},
+		{name: "Test Lp norm of empty vector", v: Vector{}, p: 2, want: 0},
+		{name: "Test p below 1 should return error", v: Vector{1}, p: 0.5, wantErr: ErrInvalidNorm},
+		{name: "Test NaN p should return error", v: Vector{1}, p: math.NaN(), wantErr: ErrInvalidNorm},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.NormP(tt.p)
+			if err != tt.wantErr {
+				t.Fatalf("NormP() error = %v, want %v", err, tt.wantErr)
+			}
+			if math.Abs(got-tt.want) > 1e-12*tt.want {
+				t.Errorf("NormP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	v := Vector{3, -4}
+	if v.Norm1() != 7 || v.Norm() != 5 || v.NormInf() != 4 {
+		t.Errorf("Norm1(), Norm(), NormInf() = %v, %v, %v", v.Norm1(), v.Norm(), v.NormInf())
+	}
+}
+
+func TestVector_Geometry(t *testing.T) {
+	x, y := Vector{1, 0, 0}, Vector{0, 1, 0}
+	if got, err := x.Cross(y); err != nil || !reflect.DeepEqual(got, Vector{0, 0, 1}) {
+		t.Errorf("Cross() = %v, %v, want [0 0 1]", got, err)
+	}
+	if got, _ := (Vector{1, 2, 3}).Cross(Vector{4, 5, 6}); !reflect.DeepEqual(got, Vector{-3, 6, -3}) {
+		t.Errorf("Cross() = %v, want [-3 6 -3]", got)
+	}
+	if _, err := (Vector{1, 2}).Cross(Vector{3, 4}); err != ErrInvalidDimensions {
+		t.Errorf("Cross() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+
+	if got, err := (Vector{3, 4}).Normalize(); err != nil || !reflect.DeepEqual(got, Vector{0.6, 0.8}) {
+		t.Errorf("Normalize() = %v, %v, want [0.6 0.8]", got, err)
+	}
+	if _, err := (Vector{0, 0}).Normalize(); err != ErrZeroVector {
+		t.Errorf("Normalize() error = %v, want %v", err, ErrZeroVector)
+	}
+
+	if got, err := (Vector{2, 3}).Project(Vector{4, 0}); err != nil || !reflect.DeepEqual(got, Vector{2, 0}) {
+		t.Errorf("Project() = %v, %v, want [2 0]", got, err)
+	}
+	if _, err := (Vector{2, 3}).Project(Vector{0, 0}); err != ErrZeroVector {
+		t.Errorf("Project() error = %v, want %v", err, ErrZeroVector)
+	}
+	if _, err := (Vector{2, 3}).Project(Vector{1}); err != ErrInvalidDimensions {
+		t.Errorf("Project() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+}
+
+func TestVector_Matrices(t *testing.T) {
+	v := Vector{1, 2}
+
+	if got := v.Outer(Vector{3, 4, 5}); !reflect.DeepEqual(got, &FlatMatrix{data: []float64{3, 4, 5, 6, 8, 10}, rows: 2, cols: 3}) {
+		t.Errorf("Outer() = %v", got)
+	}
+	if got := v.AsRow(); !reflect.DeepEqual(got, &FlatMatrix{data: []float64{1, 2}, rows: 1, cols: 2}) {
+		t.Errorf("AsRow() = %v", got)
+	}
+	col := v.AsCol()
+	if !reflect.DeepEqual(col, &FlatMatrix{data: []float64{1, 2}, rows: 2, cols: 1}) {
+		t.Errorf("AsCol() = %v", col)
+	}
+	v[0] = 9
+	if col.MustAt(0, 0) != 1 {
+		t.Errorf("AsCol() shares storage with the vector")
+	}
+
+	if got, err := VectorFromMatrix(col); err != nil || !reflect.DeepEqual(got, Vector{1, 2}) {
+		t.Errorf("VectorFromMatrix() = %v, %v", got, err)
+	}
+	if _, err := VectorFromMatrix(sparseFixtureDense); err != ErrInvalidDimensions {
+		t.Errorf("VectorFromMatrix() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+	if _, err := VectorFromMatrix(nil); err != ErrNilMatrix {
+		t.Errorf("VectorFromMatrix() error = %v, want %v", err, ErrNilMatrix)
+	}
+
+	sparse, _ := ToCSR(sparseFixtureDense)
+	for _, m := range []Matrix{sparseFixtureDense, sparse, &DiagonalMatrix{diag: []float64{1, 0, 1}}} {
+		want, _ := mulNaive(m, Vector{1, 2, 3}.AsCol())
+		got, err := MulVec(m, Vector{1, 2, 3})
+		if err != nil {
+			t.Fatalf("MulVec() error = %v", err)
+		}
+		if !reflect.DeepEqual(got.AsCol(), want) {
+			t.Errorf("MulVec() of %T = %v, want %v", m, got, want)
+		}
+	}
+	if _, err := MulVec(sparseFixtureDense, v); err != ErrInvalidDimensions {
+		t.Errorf("MulVec() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+	if _, err := MulVec(nil, v); err != ErrNilMatrix {
+		t.Errorf("MulVec() error = %v, want %v", err, ErrNilMatrix)
+	}
+}
@@ -0,0 +1,261 @@
+package algebra
+
+// MatrixView is a window into the storage of a FlatMatrix (or of another view).
+// Elements are addressed through a row stride, so a view shares memory with the matrix it
+// was taken from: writes through a view are visible in the parent and vice versa.
+type MatrixView struct {
+	// data is the backing slice shared with the parent matrix.
+	data []float64
+
+	// offset is the position in data of the element at row 0 and column 0 of the view.
+	offset int
+
+	// stride is the distance in data between the starts of two consecutive rows.
+	stride int
+
+	// rows is the number of rows in the view.
+	rows int
+
+	// cols is the number of columns in the view.
+	cols int
+}
+
+// View returns a rows x cols submatrix whose top-left element is at row i and column j,
+// sharing storage with m. Returns an error if the window does not fit inside m.
+func (m *FlatMatrix) View(i, j, rows, cols int) (Matrix, error) {
+	return newView(m.data, 0, m.cols, m.rows, m.cols, i, j, rows, cols)
+}
+
+// Row returns a 1 x cols view of row i of m.
+func (m *FlatMatrix) Row(i int) (Matrix, error) {
+	return m.View(i, 0, 1, m.cols)
+}
+
+// Col returns a rows x 1 view of column j of m.
+func (m *FlatMatrix) Col(j int) (Matrix, error) {
+	return m.View(0, j, m.rows, 1)
+}
+
+// View returns a rows x cols submatrix of the view whose top-left element is at row i and
+// column j, sharing storage with it. Returns an error if the window does not fit inside v.
+func (v *MatrixView) View(i, j, rows, cols int) (Matrix, error) {
+	return newView(v.data, v.offset, v.stride, v.rows, v.cols, i, j, rows, cols)
+}
+
+// Row returns a 1 x cols view of row i of v.
+func (v *MatrixView) Row(i int) (Matrix, error) {
+	return v.View(i, 0, 1, v.cols)
+}
+
+// Col returns a rows x 1 view of column j of v.
+func (v *MatrixView) Col(j int) (Matrix, error) {
+	return v.View(0, j, v.rows, 1)
+}
+
+func (v *MatrixView) Rows() int {
+	return v.rows
+}
+
+func (v *MatrixView) Cols() int {
+	return v.cols
+}
+
+func (v *MatrixView) At(i, j int) (float64, error) {
+	if i < 0 || i >= v.rows || j < 0 || j >= v.cols {
+		return 0, ErrorIndexOutOfBounds
+	}
+	return v.data[v.offset+i*v.stride+j], nil
+}
+
+func (v *MatrixView) MustAt(i, j int) (f float64) {
+	var err error
+	if f, err = v.At(i, j); err != nil {
+		panic(err)
+	}
+	return
+}
+
+func (v *MatrixView) Empty() bool {
+	return v.rows == 0
+}
+
+func (v *MatrixView) Add(other Matrix) (Matrix, error) {
+	return addScaled(v, other, 1)
+}
+
+func (v *MatrixView) Sub(other Matrix) (Matrix, error) {
+	return addScaled(v, other, -1)
+}
+
+func (v *MatrixView) ScalarMul(scalar float64) (Matrix, error) {
+	result := v.Copy()
+	result.ScaleInPlace(scalar)
+	return result, nil
+}
+
+func (v *MatrixView) CompareDimensions(other Matrix) bool {
+	if other == nil {
+		return false
+	}
+
+	return v.Rows() == other.Rows() && v.Cols() == other.Cols()
+}
+
+func (v *MatrixView) Mul(other Matrix) (Matrix, error) {
+	return mul(v, other)
+}
+
+// MulVec returns the product of the view and the column vector x, which must have Cols()
+// elements.
+func (v *MatrixView) MulVec(x []float64) ([]float64, error) {
+	return mulVec(v, x)
+}
+
+func (v *MatrixView) Transpose() Matrix {
+	result := &FlatMatrix{
+		data: make([]float64, v.rows*v.cols),
+		rows: v.cols,
+		cols: v.rows,
+	}
+	for i := 0; i < v.rows; i++ {
+		for j := 0; j < v.cols; j++ {
+			result.data[j*v.rows+i] = v.data[v.offset+i*v.stride+j]
+		}
+	}
+	return result
+}
+
+func (v *MatrixView) Set(i, j int, f float64) error {
+	if i < 0 || i >= v.rows || j < 0 || j >= v.cols {
+		return ErrorIndexOutOfBounds
+	}
+	v.data[v.offset+i*v.stride+j] = f
+	return nil
+}
+
+func (v *MatrixView) MustSet(i, j int, f float64) {
+	if err := v.Set(i, j, f); err != nil {
+		panic(err)
+	}
+}
+
+func (v *MatrixView) SetRow(i int, row []float64) error {
+	if i < 0 || i >= v.rows {
+		return ErrorIndexOutOfBounds
+	}
+
+	if len(row) != v.cols {
+		return ErrInvalidDimensions
+	}
+
+	copy(v.row(i), row)
+	return nil
+}
+
+func (v *MatrixView) SetCol(j int, col []float64) error {
+	if j < 0 || j >= v.cols {
+		return ErrorIndexOutOfBounds
+	}
+
+	if len(col) != v.rows {
+		return ErrInvalidDimensions
+	}
+
+	for i, f := range col {
+		v.data[v.offset+i*v.stride+j] = f
+	}
+	return nil
+}
+
+func (v *MatrixView) AddInPlace(other Matrix) error {
+	return v.addScaledInPlace(other, 1)
+}
+
+func (v *MatrixView) SubInPlace(other Matrix) error {
+	return v.addScaledInPlace(other, -1)
+}
+
+func (v *MatrixView) ScaleInPlace(scalar float64) {
+	data, ld, _ := strided(v)
+	scaleRows(scalar, data, v.rows, v.cols, ld)
+}
+
+// Copy returns a new FlatMatrix holding a copy of the elements of the view.
+func (v *MatrixView) Copy() *FlatMatrix {
+	result := &FlatMatrix{
+		data: make([]float64, v.rows*v.cols),
+		rows: v.rows,
+		cols: v.cols,
+	}
+	for i := 0; i < v.rows; i++ {
+		copy(result.data[i*v.cols:(i+1)*v.cols], v.row(i))
+	}
+	return result
+}
+
+// row returns the slice of the backing storage holding row i of the view.
+func (v *MatrixView) row(i int) []float64 {
+	start := v.offset + i*v.stride
+	return v.data[start : start+v.cols]
+}
+
+// addScaledInPlace adds alpha times other to the view element-wise, row by row through the
+// Axpy of the active backend when other is a FlatMatrix or a view. If other shares storage
+// with the view it is copied first, so overlapping operands see their original elements.
+func (v *MatrixView) addScaledInPlace(other Matrix, alpha float64) error {
+	if other == nil {
+		return ErrNilMatrix
+	}
+
+	if !v.CompareDimensions(other) {
+		return ErrInvalidDimensions
+	}
+
+	if data, _, ok := strided(other); ok && sharesStorage(v.data, data) {
+		other, _ = ToFlat(other)
+	}
+
+	if od, ld, ok := strided(other); ok {
+		be := backend()
+		for i := 0; i < v.rows; i++ {
+			be.Axpy(alpha, od[i*ld:i*ld+v.cols], v.row(i))
+		}
+		return nil
+	}
+
+	for i := 0; i < v.rows; i++ {
+		row := v.row(i)
+		for j := range row {
+			row[j] += alpha * other.MustAt(i, j)
+		}
+	}
+	return nil
+}
+
+// sharesStorage reports whether a and b are slices of the same backing array.
+func sharesStorage(a, b []float64) bool {
+	if cap(a) == 0 || cap(b) == 0 {
+		return false
+	}
+	return &a[:cap(a)][cap(a)-1] == &b[:cap(b)][cap(b)-1]
+}
+
+// newView builds the view of the window (i, j, rows, cols) over a parent of parentRows x
+// parentCols elements stored in data starting at offset with the given row stride.
+func newView(data []float64, offset, stride, parentRows, parentCols, i, j, rows, cols int) (Matrix, error) {
+	if rows < 0 || cols < 0 {
+		return nil, ErrInvalidDimensions
+	}
+
+	if i < 0 || j < 0 || i+rows > parentRows || j+cols > parentCols {
+		return nil, ErrorIndexOutOfBounds
+	}
+
+	return &MatrixView{
+		data:   data,
+		offset: offset + i*stride + j,
+		stride: stride,
+		rows:   rows,
+		cols:   cols,
+	}, nil
+}
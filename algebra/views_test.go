@@ -0,0 +1,320 @@
+package algebra
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestMatrix() *FlatMatrix {
+	return &FlatMatrix{
+		data: []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+		rows: 3,
+		cols: 4,
+	}
+}
+
+func TestFlatMatrix_View(t *testing.T) {
+	type args struct {
+		i    int
+		j    int
+		rows int
+		cols int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *FlatMatrix
+		wantErr error
+	}{
+		{
+			name: "Test 2x2 view at 0,0",
+			args: args{i: 0, j: 0, rows: 2, cols: 2},
+			want: &FlatMatrix{
+				data: []float64{1, 2, 5, 6},
+				rows: 2,
+				cols: 2,
+			},
+		},
+		{
+			name: "Test 2x3 view at 1,1",
+			args: args{i: 1, j: 1, rows: 2, cols: 3},
+			want: &FlatMatrix{
+				data: []float64{6, 7, 8, 10, 11, 12},
+				rows: 2,
+				cols: 3,
+			},
+		},
+		{
+			name: "Test full view",
+			args: args{i: 0, j: 0, rows: 3, cols: 4},
+			want: newTestMatrix(),
+		},
+		{
+			name: "Test empty view",
+			args: args{i: 3, j: 4, rows: 0, cols: 0},
+			want: &FlatMatrix{
+				data: []float64{},
+				rows: 0,
+				cols: 0,
+			},
+		},
+		{
+			name:    "Test view past the last row should return error",
+			args:    args{i: 2, j: 0, rows: 2, cols: 2},
+			wantErr: ErrorIndexOutOfBounds,
+		},
+		{
+			name:    "Test view past the last column should return error",
+			args:    args{i: 0, j: 3, rows: 1, cols: 2},
+			wantErr: ErrorIndexOutOfBounds,
+		},
+		{
+			name:    "Test view with negative size should return error",
+			args:    args{i: 0, j: 0, rows: -1, cols: 2},
+			wantErr: ErrInvalidDimensions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestMatrix()
+			got, err := m.View(tt.args.i, tt.args.j, tt.args.rows, tt.args.cols)
+			if err != tt.wantErr {
+				t.Fatalf("FlatMatrix.View() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if copied := got.(*MatrixView).Copy(); !reflect.DeepEqual(copied, tt.want) {
+				t.Errorf("FlatMatrix.View() = %v, want %v", copied, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMatrix_RowCol(t *testing.T) {
+	m := newTestMatrix()
+
+	row, err := m.Row(1)
+	if err != nil {
+		t.Fatalf("FlatMatrix.Row() error = %v", err)
+	}
+	want := &FlatMatrix{data: []float64{5, 6, 7, 8}, rows: 1, cols: 4}
+	if got := row.(*MatrixView).Copy(); !reflect.DeepEqual(got, want) {
+		t.Errorf("FlatMatrix.Row() = %v, want %v", got, want)
+	}
+
+	col, err := m.Col(2)
+	if err != nil {
+		t.Fatalf("FlatMatrix.Col() error = %v", err)
+	}
+	want = &FlatMatrix{data: []float64{3, 7, 11}, rows: 3, cols: 1}
+	if got := col.(*MatrixView).Copy(); !reflect.DeepEqual(got, want) {
+		t.Errorf("FlatMatrix.Col() = %v, want %v", got, want)
+	}
+
+	if _, err := m.Row(3); err != ErrorIndexOutOfBounds {
+		t.Errorf("FlatMatrix.Row() error = %v, want %v", err, ErrorIndexOutOfBounds)
+	}
+	if _, err := m.Col(-1); err != ErrorIndexOutOfBounds {
+		t.Errorf("FlatMatrix.Col() error = %v, want %v", err, ErrorIndexOutOfBounds)
+	}
+}
+
+func TestMatrixView_SharesStorage(t *testing.T) {
+	m := newTestMatrix()
+	v, err := m.View(1, 1, 2, 2)
+	if err != nil {
+		t.Fatalf("FlatMatrix.View() error = %v", err)
+	}
+	view := v.(*MatrixView)
+
+	view.MustSet(0, 0, 60)
+	if err := view.SetRow(1, []float64{100, 110}); err != nil {
+		t.Fatalf("MatrixView.SetRow() error = %v", err)
+	}
+	if err := view.SetCol(1, []float64{70, 111}); err != nil {
+		t.Fatalf("MatrixView.SetCol() error = %v", err)
+	}
+	want := []float64{1, 2, 3, 4, 5, 60, 70, 8, 9, 100, 111, 12}
+	if !reflect.DeepEqual(m.data, want) {
+		t.Errorf("MatrixView writes = %v, want %v", m.data, want)
+	}
+
+	m.MustSet(1, 1, 6)
+	if got := view.MustAt(0, 0); got != 6 {
+		t.Errorf("MatrixView.MustAt() = %v, want %v", got, 6)
+	}
+
+	if err := view.Set(2, 0, 1); err != ErrorIndexOutOfBounds {
+		t.Errorf("MatrixView.Set() error = %v, want %v", err, ErrorIndexOutOfBounds)
+	}
+	if err := view.SetRow(0, []float64{1}); err != ErrInvalidDimensions {
+		t.Errorf("MatrixView.SetRow() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+}
+
+func TestMatrixView_View(t *testing.T) {
+	m := newTestMatrix()
+	outer, _ := m.View(1, 1, 2, 3)
+	inner, err := outer.(*MatrixView).View(0, 1, 2, 2)
+	if err != nil {
+		t.Fatalf("MatrixView.View() error = %v", err)
+	}
+	want := &FlatMatrix{data: []float64{7, 8, 11, 12}, rows: 2, cols: 2}
+	if got := inner.(*MatrixView).Copy(); !reflect.DeepEqual(got, want) {
+		t.Errorf("MatrixView.View() = %v, want %v", got, want)
+	}
+
+	row, _ := outer.(*MatrixView).Row(1)
+	want = &FlatMatrix{data: []float64{10, 11, 12}, rows: 1, cols: 3}
+	if got := row.(*MatrixView).Copy(); !reflect.DeepEqual(got, want) {
+		t.Errorf("MatrixView.Row() = %v, want %v", got, want)
+	}
+
+	col, _ := outer.(*MatrixView).Col(0)
+	want = &FlatMatrix{data: []float64{6, 10}, rows: 2, cols: 1}
+	if got := col.(*MatrixView).Copy(); !reflect.DeepEqual(got, want) {
+		t.Errorf("MatrixView.Col() = %v, want %v", got, want)
+	}
+
+	if _, err := outer.(*MatrixView).View(1, 0, 2, 1); err != ErrorIndexOutOfBounds {
+		t.Errorf("MatrixView.View() error = %v, want %v", err, ErrorIndexOutOfBounds)
+	}
+}
+
+func TestMatrixView_Arithmetic(t *testing.T) {
+	m := newTestMatrix()
+	a, _ := m.View(0, 0, 2, 2)
+	b, _ := m.View(1, 2, 2, 2)
+
+	got, err := a.Add(b)
+	if err != nil {
+		t.Fatalf("MatrixView.Add() error = %v", err)
+	}
+	want := &FlatMatrix{data: []float64{8, 10, 16, 18}, rows: 2, cols: 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MatrixView.Add() = %v, want %v", got, want)
+	}
+
+	got, err = a.Sub(b)
+	if err != nil {
+		t.Fatalf("MatrixView.Sub() error = %v", err)
+	}
+	want = &FlatMatrix{data: []float64{-6, -6, -6, -6}, rows: 2, cols: 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MatrixView.Sub() = %v, want %v", got, want)
+	}
+
+	got, err = a.Mul(b)
+	if err != nil {
+		t.Fatalf("MatrixView.Mul() error = %v", err)
+	}
+	want = &FlatMatrix{data: []float64{29, 32, 101, 112}, rows: 2, cols: 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MatrixView.Mul() = %v, want %v", got, want)
+	}
+
+	got, err = a.ScalarMul(2)
+	if err != nil {
+		t.Fatalf("MatrixView.ScalarMul() error = %v", err)
+	}
+	want = &FlatMatrix{data: []float64{2, 4, 10, 12}, rows: 2, cols: 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MatrixView.ScalarMul() = %v, want %v", got, want)
+	}
+
+	c, _ := m.View(1, 0, 2, 3)
+	want = &FlatMatrix{data: []float64{5, 9, 6, 10, 7, 11}, rows: 3, cols: 2}
+	if got := c.Transpose(); !reflect.DeepEqual(got, want) {
+		t.Errorf("MatrixView.Transpose() = %v, want %v", got, want)
+	}
+
+	if _, err := a.Add(c); err != ErrInvalidDimensions {
+		t.Errorf("MatrixView.Add() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+	if _, err := c.Mul(c); err != ErrMulDimensions {
+		t.Errorf("MatrixView.Mul() error = %v, want %v", err, ErrMulDimensions)
+	}
+	if _, err := a.Add(nil); err != ErrNilMatrix {
+		t.Errorf("MatrixView.Add() error = %v, want %v", err, ErrNilMatrix)
+	}
+}
+
+func TestMatrixView_Empty(t *testing.T) {
+	m := newTestMatrix()
+	tests := []struct {
+		name       string
+		rows, cols int
+		want       bool
+	}{
+		{name: "Test view without rows should return true", rows: 0, cols: 2, want: true},
+		{name: "Test view without columns should match FlatMatrix", rows: 2, cols: 0, want: false},
+		{name: "Test non-empty view should return false", rows: 2, cols: 2, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := m.View(0, 0, tt.rows, tt.cols)
+			if err != nil {
+				t.Fatalf("FlatMatrix.View() error = %v", err)
+			}
+			if got := v.Empty(); got != tt.want {
+				t.Errorf("MatrixView.Empty() = %v, want %v", got, tt.want)
+			}
+			flat := &FlatMatrix{data: []float64{}, rows: tt.rows, cols: tt.cols}
+			if got := flat.Empty(); got != tt.want {
+				t.Errorf("FlatMatrix.Empty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatrixView_InPlace(t *testing.T) {
+	m := newTestMatrix()
+	v, _ := m.View(0, 2, 2, 2)
+	view := v.(*MatrixView)
+
+	other := &FlatMatrix{data: []float64{1, 1, 1, 1}, rows: 2, cols: 2}
+	if err := view.AddInPlace(other); err != nil {
+		t.Fatalf("MatrixView.AddInPlace() error = %v", err)
+	}
+	view.ScaleInPlace(2)
+	if err := view.SubInPlace(other); err != nil {
+		t.Fatalf("MatrixView.SubInPlace() error = %v", err)
+	}
+
+	want := []float64{1, 2, 7, 9, 5, 6, 15, 17, 9, 10, 11, 12}
+	if !reflect.DeepEqual(m.data, want) {
+		t.Errorf("MatrixView in-place operations = %v, want %v", m.data, want)
+	}
+
+	// The operand overlaps the view, so it must be read before any element is written.
+	m = newTestMatrix()
+	shifted, _ := m.View(0, 0, 2, 3)
+	v, _ = m.View(0, 1, 2, 3)
+	if err := v.(*MatrixView).AddInPlace(shifted); err != nil {
+		t.Fatalf("MatrixView.AddInPlace() error = %v", err)
+	}
+	want = []float64{1, 3, 5, 7, 5, 11, 13, 15, 9, 10, 11, 12}
+	if !reflect.DeepEqual(m.data, want) {
+		t.Errorf("MatrixView.AddInPlace() with overlapping operand = %v, want %v", m.data, want)
+	}
+
+	// Operands without strided storage are read element by element.
+	m = newTestMatrix()
+	v, _ = m.View(1, 1, 2, 2)
+	sparse, _ := NewCSR(2, 2, []int{0, 1, 2}, []int{1, 0}, []float64{10, 20})
+	if err := v.(*MatrixView).SubInPlace(sparse); err != nil {
+		t.Fatalf("MatrixView.SubInPlace() error = %v", err)
+	}
+	want = []float64{1, 2, 3, 4, 5, 6, -3, 8, 9, -10, 11, 12}
+	if !reflect.DeepEqual(m.data, want) {
+		t.Errorf("MatrixView.SubInPlace() with sparse operand = %v, want %v", m.data, want)
+	}
+
+	if err := view.AddInPlace(newTestMatrix()); err != ErrInvalidDimensions {
+		t.Errorf("MatrixView.AddInPlace() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+	if err := view.SubInPlace(nil); err != ErrNilMatrix {
+		t.Errorf("MatrixView.SubInPlace() error = %v, want %v", err, ErrNilMatrix)
+	}
+}